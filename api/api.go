@@ -104,6 +104,11 @@ type PhaseVoltages interface {
 	Voltages() (float64, float64, float64, error)
 }
 
+// PhaseEnergies provides per-phase imported energy kWh
+type PhaseEnergies interface {
+	Energies() (float64, float64, float64, error)
+}
+
 // PhasePowers provides signed per-phase power W
 type PhasePowers interface {
 	Powers() (float64, float64, float64, error)
@@ -152,6 +157,48 @@ type ChargerEx interface {
 	MaxCurrentMillis(current float64) error
 }
 
+// Setpoint is a charge current setpoint in A and the time it was set
+type Setpoint struct {
+	Current float64
+	Updated time.Time
+}
+
+// SetpointStatus provides the last requested and last confirmed charge current setpoint
+type SetpointStatus interface {
+	Setpoints() (requested Setpoint, confirmed Setpoint, err error)
+}
+
+// AuthorizationStatus provides whether the connected vehicle is authorized to charge, e.g. by nfc tag
+type AuthorizationStatus interface {
+	Authorized() (bool, error)
+}
+
+// PriceDisplay shows the current energy price per kWh on the charger
+type PriceDisplay interface {
+	SetPrice(price float64, currency string) error
+}
+
+// StatusTransitions provides the status changes observed by the charger since the last call, oldest first.
+// This allows detecting transitions faster than the charger status is read.
+type StatusTransitions interface {
+	Transitions() []ChargeStatus
+}
+
+// VehicleCurrentGetter provides the current the vehicle is willing to accept, which may be below the offered current
+type VehicleCurrentGetter interface {
+	GetVehicleCurrent() (float64, error)
+}
+
+// PhaseGetter provides the charger's actual number of enabled phases
+type PhaseGetter interface {
+	GetPhases() (int, error)
+}
+
+// FaultClassifier advises whether a charger fault is expected to recover without intervention
+type FaultClassifier interface {
+	TransientFault() (bool, error)
+}
+
 // PhaseSwitcher provides 1p3p switching
 type PhaseSwitcher interface {
 	Phases1p3p(phases int) error
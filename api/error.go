@@ -14,6 +14,9 @@ var ErrSponsorRequired = errors.New("sponsorship required, see https://github.co
 // ErrMissingCredentials indicates that user/password are missing
 var ErrMissingCredentials = errors.New("missing credentials")
 
+// ErrUpdating indicates that the device is temporarily unavailable due to a firmware update
+var ErrUpdating = errors.New("firmware update in progress")
+
 // ErrOutdated indicates that result is outdated
 var ErrOutdated = errors.New("outdated")
 
@@ -0,0 +1,48 @@
+package charger
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// batchConcurrency limits the number of chargers commanded concurrently
+const batchConcurrency = 8
+
+// EnableAll enables or disables all chargers concurrently, e.g. for load shedding.
+// The result contains the error of each charger by name, nil if the command succeeded.
+func EnableAll(chargers map[string]api.Charger, enable bool) map[string]error {
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		res = make(map[string]error, len(chargers))
+	)
+
+	sem := make(chan struct{}, batchConcurrency)
+
+	for name, c := range chargers {
+		wg.Add(1)
+
+		go func(name string, c api.Charger) {
+			sem <- struct{}{}
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := c.Enable(enable)
+			if err != nil {
+				err = fmt.Errorf("%s: %w", name, err)
+			}
+
+			mu.Lock()
+			res[name] = err
+			mu.Unlock()
+		}(name, c)
+	}
+
+	wg.Wait()
+
+	return res
+}
@@ -0,0 +1,25 @@
+package charger
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnableAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	foo := api.NewMockCharger(ctrl)
+	bar := api.NewMockCharger(ctrl)
+
+	foo.EXPECT().Enable(false).Return(nil)
+	bar.EXPECT().Enable(false).Return(api.ErrTimeout)
+
+	res := EnableAll(map[string]api.Charger{"foo": foo, "bar": bar}, false)
+	assert.Len(t, res, 2)
+	assert.NoError(t, res["foo"])
+	assert.ErrorIs(t, res["bar"], api.ErrTimeout)
+	assert.EqualError(t, res["bar"], "bar: timeout")
+}
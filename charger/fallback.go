@@ -0,0 +1,227 @@
+package charger
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+)
+
+// Fallback is an api.Charger implementation that uses a primary charger and falls back
+// to a secondary charger, e.g. a different transport to the same device, if the primary fails
+type Fallback struct {
+	log       *util.Logger
+	mu        sync.Mutex
+	degraded  map[string]bool
+	primary   api.Charger
+	secondary api.Charger
+}
+
+func init() {
+	registry.Add("fallback", NewFallbackFromConfig)
+}
+
+//go:generate go run ../cmd/tools/decorate.go -f decorateFallback -b *Fallback -r api.Charger -t "api.ChargerEx,MaxCurrentMillis,func(float64) error" -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.Identifier,Identify,func() (string, error)" -t "api.PhaseSwitcher,Phases1p3p,func(int) error"
+
+// NewFallbackFromConfig creates a fallback charger from generic config
+func NewFallbackFromConfig(other map[string]interface{}) (api.Charger, error) {
+	var cc struct {
+		Primary, Secondary config.Typed
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	primary, err := NewFromConfig(cc.Primary.Type, cc.Primary.Other)
+	if err != nil {
+		return nil, fmt.Errorf("primary: %w", err)
+	}
+
+	secondary, err := NewFromConfig(cc.Secondary.Type, cc.Secondary.Other)
+	if err != nil {
+		return nil, fmt.Errorf("secondary: %w", err)
+	}
+
+	return NewFallback(primary, secondary), nil
+}
+
+// NewFallback creates a fallback charger. It implements the union of the optional
+// interfaces of both chargers. Reads and writes are directed to the primary charger
+// first and retried using the secondary charger if the primary fails.
+func NewFallback(primary, secondary api.Charger) api.Charger {
+	c := &Fallback{
+		log:       util.NewLogger("fallback"),
+		degraded:  make(map[string]bool),
+		primary:   primary,
+		secondary: secondary,
+	}
+
+	var maxCurrentMillis func(float64) error
+	_, p := primary.(api.ChargerEx)
+	_, s := secondary.(api.ChargerEx)
+	if p || s {
+		maxCurrentMillis = c.maxCurrentMillis
+	}
+
+	var currentPower, totalEnergy func() (float64, error)
+	if m, ok := primary.(api.Meter); ok {
+		currentPower = m.CurrentPower
+	}
+	if m, ok := secondary.(api.Meter); ok {
+		currentPower = fallbackGetter(c, "power", currentPower, m.CurrentPower)
+	}
+	if m, ok := primary.(api.MeterEnergy); ok {
+		totalEnergy = m.TotalEnergy
+	}
+	if m, ok := secondary.(api.MeterEnergy); ok {
+		totalEnergy = fallbackGetter(c, "energy", totalEnergy, m.TotalEnergy)
+	}
+
+	var currents, voltages func() (float64, float64, float64, error)
+	if m, ok := primary.(api.PhaseCurrents); ok {
+		currents = m.Currents
+	}
+	if m, ok := secondary.(api.PhaseCurrents); ok {
+		currents = fallbackPhaseGetter(c, "currents", currents, m.Currents)
+	}
+	if m, ok := primary.(api.PhaseVoltages); ok {
+		voltages = m.Voltages
+	}
+	if m, ok := secondary.(api.PhaseVoltages); ok {
+		voltages = fallbackPhaseGetter(c, "voltages", voltages, m.Voltages)
+	}
+
+	var identify func() (string, error)
+	if i, ok := primary.(api.Identifier); ok {
+		identify = i.Identify
+	}
+	if i, ok := secondary.(api.Identifier); ok {
+		identify = fallbackGetter(c, "identify", identify, i.Identify)
+	}
+
+	var phases1p3p func(int) error
+	if ps, ok := primary.(api.PhaseSwitcher); ok {
+		phases1p3p = ps.Phases1p3p
+	}
+	if ps, ok := secondary.(api.PhaseSwitcher); ok {
+		if p := phases1p3p; p != nil {
+			phases1p3p = func(phases int) error {
+				return c.write("phases", func() error { return p(phases) }, func() error { return ps.Phases1p3p(phases) })
+			}
+		} else {
+			phases1p3p = ps.Phases1p3p
+		}
+	}
+
+	return decorateFallback(c, maxCurrentMillis, currentPower, totalEnergy, currents, voltages, identify, phases1p3p)
+}
+
+// track logs transitions between primary and secondary charger per operation
+func (c *Fallback) track(op string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil && !c.degraded[op] {
+		c.log.WARN.Printf("%s: primary failed, using secondary: %v", op, err)
+	} else if err == nil && c.degraded[op] {
+		c.log.INFO.Printf("%s: primary recovered", op)
+	}
+
+	c.degraded[op] = err != nil
+}
+
+// write directs writes to the primary charger and retries using the secondary charger
+func (c *Fallback) write(op string, primary, secondary func() error) error {
+	err := primary()
+	c.track(op, err)
+
+	if err != nil {
+		err = secondary()
+	}
+
+	return err
+}
+
+// fallbackGetter combines optional primary and secondary getters
+func fallbackGetter[T any](c *Fallback, op string, primary, secondary func() (T, error)) func() (T, error) {
+	if primary == nil {
+		return secondary
+	}
+
+	return func() (T, error) {
+		res, err := primary()
+		c.track(op, err)
+
+		if err != nil {
+			res, err = secondary()
+		}
+
+		return res, err
+	}
+}
+
+// fallbackPhaseGetter combines optional primary and secondary per-phase getters
+func fallbackPhaseGetter(c *Fallback, op string, primary, secondary func() (float64, float64, float64, error)) func() (float64, float64, float64, error) {
+	if primary == nil {
+		return secondary
+	}
+
+	tuple := func(g func() (float64, float64, float64, error)) func() ([3]float64, error) {
+		return func() ([3]float64, error) {
+			l1, l2, l3, err := g()
+			return [3]float64{l1, l2, l3}, err
+		}
+	}
+
+	g := fallbackGetter(c, op, tuple(primary), tuple(secondary))
+
+	return func() (float64, float64, float64, error) {
+		res, err := g()
+		return res[0], res[1], res[2], err
+	}
+}
+
+// Status implements the api.Charger interface
+func (c *Fallback) Status() (api.ChargeStatus, error) {
+	return fallbackGetter(c, "status", c.primary.Status, c.secondary.Status)()
+}
+
+// Enabled implements the api.Charger interface
+func (c *Fallback) Enabled() (bool, error) {
+	return fallbackGetter(c, "enabled", c.primary.Enabled, c.secondary.Enabled)()
+}
+
+// Enable implements the api.Charger interface
+func (c *Fallback) Enable(enable bool) error {
+	return c.write("enable", func() error {
+		return c.primary.Enable(enable)
+	}, func() error {
+		return c.secondary.Enable(enable)
+	})
+}
+
+// MaxCurrent implements the api.Charger interface
+func (c *Fallback) MaxCurrent(current int64) error {
+	return c.write("maxcurrent", func() error {
+		return c.primary.MaxCurrent(current)
+	}, func() error {
+		return c.secondary.MaxCurrent(current)
+	})
+}
+
+// maxCurrentMillis implements the api.ChargerEx interface
+func (c *Fallback) maxCurrentMillis(current float64) error {
+	set := func(charger api.Charger) func() error {
+		return func() error {
+			if c, ok := charger.(api.ChargerEx); ok {
+				return c.MaxCurrentMillis(current)
+			}
+			return charger.MaxCurrent(int64(current))
+		}
+	}
+
+	return c.write("maxcurrent", set(c.primary), set(c.secondary))
+}
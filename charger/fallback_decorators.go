@@ -0,0 +1,2753 @@
+package charger
+
+// Code generated by github.com/evcc-io/evcc/cmd/tools/decorate.go. DO NOT EDIT.
+
+import (
+	"github.com/evcc-io/evcc/api"
+)
+
+func decorateFallback(base *Fallback, chargerEx func(float64) error, meter func() (float64, error), meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error), identifier func() (string, error), phaseSwitcher func(int) error) api.Charger {
+	switch {
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return base
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx == nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case chargerEx != nil && identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+		return &struct {
+			*Fallback
+			api.ChargerEx
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Fallback: base,
+			ChargerEx: &decorateFallbackChargerExImpl{
+				chargerEx: chargerEx,
+			},
+			Identifier: &decorateFallbackIdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateFallbackMeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateFallbackMeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateFallbackPhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseSwitcher: &decorateFallbackPhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateFallbackPhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+	}
+
+	return nil
+}
+
+type decorateFallbackChargerExImpl struct {
+	chargerEx func(float64) error
+}
+
+func (impl *decorateFallbackChargerExImpl) MaxCurrentMillis(p0 float64) error {
+	return impl.chargerEx(p0)
+}
+
+type decorateFallbackIdentifierImpl struct {
+	identifier func() (string, error)
+}
+
+func (impl *decorateFallbackIdentifierImpl) Identify() (string, error) {
+	return impl.identifier()
+}
+
+type decorateFallbackMeterImpl struct {
+	meter func() (float64, error)
+}
+
+func (impl *decorateFallbackMeterImpl) CurrentPower() (float64, error) {
+	return impl.meter()
+}
+
+type decorateFallbackMeterEnergyImpl struct {
+	meterEnergy func() (float64, error)
+}
+
+func (impl *decorateFallbackMeterEnergyImpl) TotalEnergy() (float64, error) {
+	return impl.meterEnergy()
+}
+
+type decorateFallbackPhaseCurrentsImpl struct {
+	phaseCurrents func() (float64, float64, float64, error)
+}
+
+func (impl *decorateFallbackPhaseCurrentsImpl) Currents() (float64, float64, float64, error) {
+	return impl.phaseCurrents()
+}
+
+type decorateFallbackPhaseSwitcherImpl struct {
+	phaseSwitcher func(int) error
+}
+
+func (impl *decorateFallbackPhaseSwitcherImpl) Phases1p3p(p0 int) error {
+	return impl.phaseSwitcher(p0)
+}
+
+type decorateFallbackPhaseVoltagesImpl struct {
+	phaseVoltages func() (float64, float64, float64, error)
+}
+
+func (impl *decorateFallbackPhaseVoltagesImpl) Voltages() (float64, float64, float64, error) {
+	return impl.phaseVoltages()
+}
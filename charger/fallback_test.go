@@ -0,0 +1,95 @@
+package charger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackFailover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	primary := api.NewMockCharger(ctrl)
+	secondary := api.NewMockCharger(ctrl)
+
+	wb := NewFallback(primary, secondary)
+
+	// primary healthy
+	primary.EXPECT().Status().Return(api.StatusB, nil)
+	res, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, res)
+
+	// primary failed
+	primary.EXPECT().Status().Return(api.StatusNone, api.ErrTimeout)
+	secondary.EXPECT().Status().Return(api.StatusC, nil)
+	res, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, res)
+
+	// both failed
+	primary.EXPECT().Status().Return(api.StatusNone, api.ErrTimeout)
+	secondary.EXPECT().Status().Return(api.StatusNone, errors.New("foo"))
+	_, err = wb.Status()
+	assert.Error(t, err)
+
+	// primary recovered
+	primary.EXPECT().Status().Return(api.StatusA, nil)
+	res, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusA, res)
+}
+
+func TestFallbackWrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	primary := api.NewMockCharger(ctrl)
+	secondary := api.NewMockCharger(ctrl)
+
+	wb := NewFallback(primary, secondary)
+
+	primary.EXPECT().MaxCurrent(int64(16)).Return(nil)
+	require.NoError(t, wb.MaxCurrent(16))
+
+	primary.EXPECT().Enable(true).Return(api.ErrTimeout)
+	secondary.EXPECT().Enable(true).Return(nil)
+	require.NoError(t, wb.Enable(true))
+}
+
+func TestFallbackUnion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	primary := api.NewMockCharger(ctrl)
+	meter := api.NewMockMeter(ctrl)
+	identifier := api.NewMockIdentifier(ctrl)
+
+	secondary := struct {
+		*api.MockCharger
+		*api.MockMeter
+		*api.MockIdentifier
+	}{
+		api.NewMockCharger(ctrl), meter, identifier,
+	}
+
+	wb := NewFallback(primary, secondary)
+
+	if _, ok := wb.(api.PhaseSwitcher); ok {
+		t.Error("unexpected PhaseSwitcher api")
+	}
+
+	m, ok := wb.(api.Meter)
+	require.True(t, ok, "missing Meter api")
+
+	meter.EXPECT().CurrentPower().Return(1e3, nil)
+	res, err := m.CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, 1e3, res)
+
+	if _, ok := wb.(api.Identifier); !ok {
+		t.Error("missing Identifier api")
+	}
+}
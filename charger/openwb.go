@@ -100,7 +100,7 @@ func NewOpenWB(log *util.Logger, mqttconf mqtt.Config, id int, topic string, p1p
 		currentTopic = "Lp2" + openwb.SlaveChargeCurrentTopic
 	}
 	currentS, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/set/isss/%s", topic, currentTopic),
-		timeout).WithRetained().IntSetter("current")
+		timeout).WithRetained(true).IntSetter("current")
 	if err != nil {
 		return nil, err
 	}
@@ -111,13 +111,13 @@ func NewOpenWB(log *util.Logger, mqttconf mqtt.Config, id int, topic string, p1p
 		cpTopic = strings.TrimSuffix(cpTopic, "1") + "2"
 	}
 	wakeupS, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/set/isss/%s", topic, cpTopic),
-		timeout).WithRetained().IntSetter("cp")
+		timeout).WithRetained(true).IntSetter("cp")
 	if err != nil {
 		return nil, err
 	}
 
 	authS, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/set/chargepoint/%d/set/%s", topic, id, openwb.RfidTopic),
-		timeout).WithRetained().StringSetter("rfid")
+		timeout).WithRetained(true).StringSetter("rfid")
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +153,7 @@ func NewOpenWB(log *util.Logger, mqttconf mqtt.Config, id int, topic string, p1p
 
 	// heartbeat
 	heartbeatS, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/set/isss/%s", topic, openwb.SlaveHeartbeatTopic),
-		timeout).WithRetained().IntSetter("heartbeat")
+		timeout).WithRetained(true).IntSetter("heartbeat")
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +176,7 @@ func NewOpenWB(log *util.Logger, mqttconf mqtt.Config, id int, topic string, p1p
 			phasesTopic += "Lp2"
 		}
 		phasesS, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/set/isss/%s", topic, phasesTopic),
-			timeout).WithRetained().IntSetter("phases")
+			timeout).WithRetained(true).IntSetter("phases")
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,62 @@
+package warp
+
+import (
+	"sync"
+	"time"
+)
+
+// Cadence measures the typical interval between messages for detecting stale data faster than a fixed timeout
+// on boxes publishing frequently without flagging slow but regular boxes
+type Cadence struct {
+	mu       sync.Mutex
+	last     time.Time
+	interval time.Duration // moving average
+	samples  int
+}
+
+// Update records a message received at ts
+func (c *Cadence) Update(ts time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.last.IsZero() {
+		d := ts.Sub(c.last)
+
+		if c.samples == 0 {
+			c.interval = d
+		} else {
+			c.interval += (d - c.interval) / CadenceWeight
+		}
+		c.samples++
+	}
+
+	c.last = ts
+}
+
+// Interval returns the observed interval or false if not enough messages were received
+func (c *Cadence) Interval() (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.interval, c.samples >= CadenceSamples
+}
+
+// Timeout returns a multiple of the observed interval limited by the manual timeout. Until enough messages
+// were received the manual timeout applies.
+func (c *Cadence) Timeout(timeout time.Duration) time.Duration {
+	interval, ok := c.Interval()
+	if !ok {
+		return timeout
+	}
+
+	return min(max(CadenceFactor*interval, CadenceMin), timeout)
+}
+
+// Stale returns true if no message was received within the adaptive timeout before ts
+func (c *Cadence) Stale(ts time.Time, timeout time.Duration) bool {
+	d := c.Timeout(timeout)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return !c.last.IsZero() && ts.Sub(c.last) > d
+}
@@ -0,0 +1,46 @@
+package warp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCadence(t *testing.T) {
+	const timeout = 30 * time.Second
+
+	var c Cadence
+	ts := time.Now()
+
+	// no messages
+	assert.Equal(t, timeout, c.Timeout(timeout))
+	assert.False(t, c.Stale(ts, timeout))
+
+	for i := 0; i < CadenceSamples; i++ {
+		c.Update(ts)
+		assert.Equal(t, timeout, c.Timeout(timeout))
+		ts = ts.Add(time.Second)
+	}
+
+	// fast box
+	c.Update(ts)
+	assert.Equal(t, CadenceFactor*time.Second, c.Timeout(timeout))
+	assert.False(t, c.Stale(ts.Add(4*time.Second), timeout))
+	assert.True(t, c.Stale(ts.Add(6*time.Second), timeout))
+
+	// slow box limited by manual timeout
+	for i := 0; i < 50; i++ {
+		ts = ts.Add(10 * time.Second)
+		c.Update(ts)
+	}
+	assert.Equal(t, timeout, c.Timeout(timeout))
+	assert.False(t, c.Stale(ts.Add(25*time.Second), timeout))
+
+	// very fast box limited by lower bound
+	for i := 0; i < 100; i++ {
+		ts = ts.Add(100 * time.Millisecond)
+		c.Update(ts)
+	}
+	assert.Equal(t, CadenceMin, c.Timeout(timeout))
+}
@@ -0,0 +1,104 @@
+package warp
+
+import (
+	"cmp"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+type coalescer[T cmp.Ordered] struct {
+	mu        sync.Mutex
+	log       *util.Logger
+	interval  time.Duration
+	set       func(T) error
+	updated   time.Time
+	published T
+	pending   T
+	timer     *time.Timer
+	seq       int
+}
+
+// Coalesce limits the rate of increases published by set to once per interval. Increases within the
+// interval are collapsed and the latest value is published when the interval has elapsed. Decreases,
+// e.g. disabling, are published immediately and discard pending increases. Errors of deferred
+// publishes are logged. A zero interval returns set unchanged.
+func Coalesce[T cmp.Ordered](log *util.Logger, interval time.Duration, set func(T) error) func(T) error {
+	if interval == 0 {
+		return set
+	}
+
+	c := &coalescer[T]{
+		log:      log,
+		interval: interval,
+		set:      set,
+	}
+
+	return c.Set
+}
+
+func (c *coalescer[T]) Set(v T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.updated.IsZero() || v <= c.published {
+		c.cancel()
+		return c.publish(v)
+	}
+
+	// publish already scheduled
+	if c.timer != nil {
+		c.pending = v
+		return nil
+	}
+
+	if d := c.interval - time.Since(c.updated); d > 0 {
+		c.pending = v
+		seq := c.seq
+		c.timer = time.AfterFunc(d, func() { c.flush(seq) })
+		return nil
+	}
+
+	return c.publish(v)
+}
+
+// cancel discards the pending value. Requires holding the lock.
+func (c *coalescer[T]) cancel() {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.seq++
+}
+
+// publish publishes v. Requires holding the lock.
+func (c *coalescer[T]) publish(v T) error {
+	c.updated = time.Now()
+
+	err := c.set(v)
+	if err == nil {
+		c.published = v
+	} else {
+		// publish again on next call
+		c.updated = time.Time{}
+	}
+
+	return err
+}
+
+func (c *coalescer[T]) flush(seq int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// cancelled while waiting for the lock
+	if seq != c.seq {
+		return
+	}
+
+	c.timer = nil
+
+	if err := c.publish(c.pending); err != nil {
+		c.log.ERROR.Printf("deferred publish: %v", err)
+	}
+}
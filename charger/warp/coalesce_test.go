@@ -0,0 +1,70 @@
+package warp
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesce(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		res []int64
+	)
+
+	set := Coalesce(util.NewLogger("foo"), 50*time.Millisecond, func(v int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+		res = append(res, v)
+		return nil
+	})
+
+	for v := int64(1); v <= 3; v++ {
+		require.NoError(t, set(v))
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(res) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	// first value published immediately, latest value after interval
+	assert.Equal(t, []int64{1, 3}, res)
+}
+
+func TestCoalesceDecrease(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		res []int64
+	)
+
+	set := Coalesce(util.NewLogger("foo"), 50*time.Millisecond, func(v int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+		res = append(res, v)
+		return nil
+	})
+
+	published := func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(res)
+	}
+
+	require.NoError(t, set(6))
+	require.NoError(t, set(16))
+	assert.Equal(t, []int64{6}, published())
+
+	// decrease published immediately, pending increase discarded
+	require.NoError(t, set(0))
+	assert.Equal(t, []int64{6, 0}, published())
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, []int64{6, 0}, published())
+}
@@ -3,6 +3,70 @@ package warp
 import "time"
 
 const (
-	RootTopic = "warp"
-	Timeout   = 30 * time.Second
+	RootTopic    = "warp"
+	Timeout      = 30 * time.Second
+	StartupDedup = 500 * time.Millisecond // ignore identical messages of frequently published topics after subscribing
+	CommandQoS   = 1                      // commands are not dropped on flaky networks
+
+	MinCurrent = 6000  // mA
+	MaxCurrent = 32000 // mA
+
+	ReassertInterval = time.Minute     // publish unchanged current
+	MeterCache       = time.Second     // share meter values between interfaces within one update
+	MeterSlots       = 7               // meter slots of boxes with multiple meters
+	MeterMissing     = 5 * time.Minute // no meter data despite advertised meter feature
+
+	StopTimeout  = 10 * time.Second // charge tracker confirmation of stop command
+	StopInterval = time.Second
+
+	CadenceFactor  = 5               // adaptive timeout as multiple of the low level state interval
+	CadenceMin     = 3 * time.Second // lower bound of the adaptive timeout
+	CadenceSamples = 5               // intervals observed before adapting the timeout
+	CadenceWeight  = 8               // moving average weight of the interval
+
+	OfferedTolerance = 0.5              // A, duty cycle resolution and rounding of the offered current
+	OfferedGrace     = 10 * time.Second // offered current following setpoint changes
+
+	RefreshTimeout = 10 * time.Second // nfc config readback after requesting a tag list reload
+
+	TransitionBuffer = 16 // status transitions kept between status reads
+
+	ClearTimeout  = 10 * time.Second // evse state readback after requesting an error reset
+	ClearAttempts = 3                // automatic error resets per fault before giving up
+	ClearBackoff  = 30 * time.Second // delay before the first automatic error reset, doubled per attempt
+
+	TemperatureMin = -40.0 // °C, plausible box temperature readings
+	TemperatureMax = 125.0
+
+	EnergyReset = 0.1 // kWh, lower meter readings are accepted as counter reset
+
+	IndicatorEvse     = -1   // led controlled by the box
+	IndicatorBlink    = 1001 // acknowledge blink
+	IndicatorDuration = 5 * time.Second
+	IndicatorInterval = 10 * time.Second
+
+	MonitorInterval = time.Minute // monitoring heartbeat
+
+	SocInterval = 30 * time.Second // front panel vehicle soc updates
+	SocNone     = -1               // clear front panel vehicle soc
+
+	EventLogEntries = 20 // recent event log entries shown by diagnose
+
+	ConflictWarn  = "warn"  // warn about sessions started at the box
+	ConflictYield = "yield" // yield control to sessions started at the box
+
+	NominalVoltage = 230 // V, phase voltage
+	VoltageSag     = 10  // %, deviation below nominal voltage flagged as sag
+
+	PowerTotal  = "total"  // meter total power register
+	PowerPhases = "phases" // sum of phase powers
+
+	PowerImport = "import" // meter power is positive when charging
+	PowerExport = "export" // meter power is positive when discharging, e.g. reversed meter wiring
+
+	TransportMqtt = "mqtt" // broker
+	TransportWs   = "ws"   // box websocket and http api
+
+	ShutdownHold    = "hold"    // keep last current on shutdown
+	ShutdownRelease = "release" // return control to the box on shutdown
 )
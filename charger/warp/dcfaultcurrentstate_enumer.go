@@ -0,0 +1,90 @@
+// Code generated by "enumer -type DcFaultCurrentState -trimprefix DcFaultCurrent -transform whitespace"; DO NOT EDIT.
+
+package warp
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _DcFaultCurrentStateName = "okresidual currentsystem errorunknown errorcalibration error"
+
+var _DcFaultCurrentStateIndex = [...]uint8{0, 2, 18, 30, 43, 60}
+
+const _DcFaultCurrentStateLowerName = "okresidual currentsystem errorunknown errorcalibration error"
+
+func (i DcFaultCurrentState) String() string {
+	if i < 0 || i >= DcFaultCurrentState(len(_DcFaultCurrentStateIndex)-1) {
+		return fmt.Sprintf("DcFaultCurrentState(%d)", i)
+	}
+	return _DcFaultCurrentStateName[_DcFaultCurrentStateIndex[i]:_DcFaultCurrentStateIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _DcFaultCurrentStateNoOp() {
+	var x [1]struct{}
+	_ = x[DcFaultCurrentOk-(0)]
+	_ = x[DcFaultCurrentResidualCurrent-(1)]
+	_ = x[DcFaultCurrentSystemError-(2)]
+	_ = x[DcFaultCurrentUnknownError-(3)]
+	_ = x[DcFaultCurrentCalibrationError-(4)]
+}
+
+var _DcFaultCurrentStateValues = []DcFaultCurrentState{DcFaultCurrentOk, DcFaultCurrentResidualCurrent, DcFaultCurrentSystemError, DcFaultCurrentUnknownError, DcFaultCurrentCalibrationError}
+
+var _DcFaultCurrentStateNameToValueMap = map[string]DcFaultCurrentState{
+	_DcFaultCurrentStateName[0:2]:        DcFaultCurrentOk,
+	_DcFaultCurrentStateLowerName[0:2]:   DcFaultCurrentOk,
+	_DcFaultCurrentStateName[2:18]:       DcFaultCurrentResidualCurrent,
+	_DcFaultCurrentStateLowerName[2:18]:  DcFaultCurrentResidualCurrent,
+	_DcFaultCurrentStateName[18:30]:      DcFaultCurrentSystemError,
+	_DcFaultCurrentStateLowerName[18:30]: DcFaultCurrentSystemError,
+	_DcFaultCurrentStateName[30:43]:      DcFaultCurrentUnknownError,
+	_DcFaultCurrentStateLowerName[30:43]: DcFaultCurrentUnknownError,
+	_DcFaultCurrentStateName[43:60]:      DcFaultCurrentCalibrationError,
+	_DcFaultCurrentStateLowerName[43:60]: DcFaultCurrentCalibrationError,
+}
+
+var _DcFaultCurrentStateNames = []string{
+	_DcFaultCurrentStateName[0:2],
+	_DcFaultCurrentStateName[2:18],
+	_DcFaultCurrentStateName[18:30],
+	_DcFaultCurrentStateName[30:43],
+	_DcFaultCurrentStateName[43:60],
+}
+
+// DcFaultCurrentStateString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func DcFaultCurrentStateString(s string) (DcFaultCurrentState, error) {
+	if val, ok := _DcFaultCurrentStateNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _DcFaultCurrentStateNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to DcFaultCurrentState values", s)
+}
+
+// DcFaultCurrentStateValues returns all values of the enum
+func DcFaultCurrentStateValues() []DcFaultCurrentState {
+	return _DcFaultCurrentStateValues
+}
+
+// DcFaultCurrentStateStrings returns a slice of all String values of the enum
+func DcFaultCurrentStateStrings() []string {
+	strs := make([]string, len(_DcFaultCurrentStateNames))
+	copy(strs, _DcFaultCurrentStateNames)
+	return strs
+}
+
+// IsADcFaultCurrentState returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i DcFaultCurrentState) IsADcFaultCurrentState() bool {
+	for _, v := range _DcFaultCurrentStateValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
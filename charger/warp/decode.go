@@ -0,0 +1,58 @@
+package warp
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Unmarshal decodes JSON data like json.Unmarshal. For struct fields missing from the data,
+// alternate names from the comma-separated `alt` field tag are tried to cope with renamed fields
+// across firmware versions. The json tag remains the primary name. The alternate names used are returned.
+func Unmarshal(data []byte, res any) ([]string, error) {
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, err
+	}
+
+	v := reflect.ValueOf(res)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return nil, nil
+	}
+	v = v.Elem()
+
+	var fields map[string]json.RawMessage
+	var used []string
+
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+
+		alt, ok := f.Tag.Lookup("alt")
+		if !ok || !f.IsExported() {
+			continue
+		}
+
+		// lazily decode object keys
+		if fields == nil {
+			if err := json.Unmarshal(data, &fields); err != nil {
+				return nil, err
+			}
+		}
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if _, ok := fields[name]; ok {
+			continue
+		}
+
+		for _, name := range strings.Split(alt, ",") {
+			if raw, ok := fields[name]; ok {
+				if err := json.Unmarshal(raw, v.Field(i).Addr().Interface()); err != nil {
+					return nil, err
+				}
+				used = append(used, name)
+				break
+			}
+		}
+	}
+
+	return used, nil
+}
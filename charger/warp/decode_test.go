@@ -0,0 +1,28 @@
+package warp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal(t *testing.T) {
+	for _, tc := range []struct {
+		data     string
+		expected MeterValues
+		used     []string
+	}{
+		{`{"power":1,"energy_abs":2}`, MeterValues{Power: 1, EnergyAbs: 2}, nil},
+		{`{"power":1,"energy_absolute":2}`, MeterValues{Power: 1, EnergyAbs: 2}, []string{"energy_absolute"}},
+		// primary name wins
+		{`{"energy_abs":2,"energy_absolute":3}`, MeterValues{EnergyAbs: 2}, nil},
+	} {
+		var res MeterValues
+
+		used, err := Unmarshal([]byte(tc.data), &res)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, res, tc.data)
+		assert.Equal(t, tc.used, used, tc.data)
+	}
+}
@@ -0,0 +1,51 @@
+package warp
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+)
+
+// ErrNonFinite is returned for payloads containing null or non-finite numbers
+var ErrNonFinite = errors.New("non-finite value")
+
+var nonFinite = regexp.MustCompile(`(?i)\b(nan|inf|infinity)\b`)
+
+// CheckFinite returns ErrNonFinite if the payload contains null, NaN or infinite numbers.
+// Some meters transiently publish these instead of a reading.
+func CheckFinite(data string) error {
+	if nonFinite.MatchString(data) {
+		return ErrNonFinite
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		return err
+	}
+
+	if hasNull(v) {
+		return ErrNonFinite
+	}
+
+	return nil
+}
+
+func hasNull(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case []any:
+		for _, e := range v {
+			if hasNull(e) {
+				return true
+			}
+		}
+	case map[string]any:
+		for _, e := range v {
+			if hasNull(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package warp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckFinite(t *testing.T) {
+	for _, tc := range []struct {
+		data     string
+		expected error
+	}{
+		{`{"power":1.5,"energy_abs":2}`, nil},
+		{`[230,230,230,1]`, nil},
+		{`{"power":null,"energy_abs":2}`, ErrNonFinite},
+		{`[230,NaN,230]`, ErrNonFinite},
+		{`[230,-Infinity,230]`, ErrNonFinite},
+		{`[230,null,230]`, ErrNonFinite},
+	} {
+		assert.ErrorIs(t, CheckFinite(tc.data), tc.expected, tc.data)
+	}
+}
@@ -0,0 +1,98 @@
+// Code generated by "enumer -type NotCharging -trimprefix NotCharging -transform whitespace"; DO NOT EDIT.
+
+package warp
+
+import (
+	"fmt"
+	"strings"
+)
+
+const _NotChargingName = "nonedisabledauthorizationreleaselimitfaultvehicle"
+
+var _NotChargingIndex = [...]uint8{0, 4, 12, 25, 32, 37, 42, 49}
+
+const _NotChargingLowerName = "nonedisabledauthorizationreleaselimitfaultvehicle"
+
+func (i NotCharging) String() string {
+	if i < 0 || i >= NotCharging(len(_NotChargingIndex)-1) {
+		return fmt.Sprintf("NotCharging(%d)", i)
+	}
+	return _NotChargingName[_NotChargingIndex[i]:_NotChargingIndex[i+1]]
+}
+
+// An "invalid array index" compiler error signifies that the constant values have changed.
+// Re-run the stringer command to generate them again.
+func _NotChargingNoOp() {
+	var x [1]struct{}
+	_ = x[NotChargingNone-(0)]
+	_ = x[NotChargingDisabled-(1)]
+	_ = x[NotChargingAuthorization-(2)]
+	_ = x[NotChargingRelease-(3)]
+	_ = x[NotChargingLimit-(4)]
+	_ = x[NotChargingFault-(5)]
+	_ = x[NotChargingVehicle-(6)]
+}
+
+var _NotChargingValues = []NotCharging{NotChargingNone, NotChargingDisabled, NotChargingAuthorization, NotChargingRelease, NotChargingLimit, NotChargingFault, NotChargingVehicle}
+
+var _NotChargingNameToValueMap = map[string]NotCharging{
+	_NotChargingName[0:4]:        NotChargingNone,
+	_NotChargingLowerName[0:4]:   NotChargingNone,
+	_NotChargingName[4:12]:       NotChargingDisabled,
+	_NotChargingLowerName[4:12]:  NotChargingDisabled,
+	_NotChargingName[12:25]:      NotChargingAuthorization,
+	_NotChargingLowerName[12:25]: NotChargingAuthorization,
+	_NotChargingName[25:32]:      NotChargingRelease,
+	_NotChargingLowerName[25:32]: NotChargingRelease,
+	_NotChargingName[32:37]:      NotChargingLimit,
+	_NotChargingLowerName[32:37]: NotChargingLimit,
+	_NotChargingName[37:42]:      NotChargingFault,
+	_NotChargingLowerName[37:42]: NotChargingFault,
+	_NotChargingName[42:49]:      NotChargingVehicle,
+	_NotChargingLowerName[42:49]: NotChargingVehicle,
+}
+
+var _NotChargingNames = []string{
+	_NotChargingName[0:4],
+	_NotChargingName[4:12],
+	_NotChargingName[12:25],
+	_NotChargingName[25:32],
+	_NotChargingName[32:37],
+	_NotChargingName[37:42],
+	_NotChargingName[42:49],
+}
+
+// NotChargingString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func NotChargingString(s string) (NotCharging, error) {
+	if val, ok := _NotChargingNameToValueMap[s]; ok {
+		return val, nil
+	}
+
+	if val, ok := _NotChargingNameToValueMap[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to NotCharging values", s)
+}
+
+// NotChargingValues returns all values of the enum
+func NotChargingValues() []NotCharging {
+	return _NotChargingValues
+}
+
+// NotChargingStrings returns a slice of all String values of the enum
+func NotChargingStrings() []string {
+	strs := make([]string, len(_NotChargingNames))
+	copy(strs, _NotChargingNames)
+	return strs
+}
+
+// IsANotCharging returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i NotCharging) IsANotCharging() bool {
+	for _, v := range _NotChargingValues {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package warp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+)
+
+// PollInterval is the http api polling interval of topics the broker refused to subscribe
+var PollInterval = 5 * time.Second
+
+// Poll implements the provider.StringProvider interface by reading a topic from the box's http api
+type Poll struct {
+	*request.Helper
+	uri string
+}
+
+var _ provider.StringProvider = (*Poll)(nil)
+
+// NewPoll creates a http api reader for the topic below the root topic, e.g. evse/state
+func NewPoll(log *util.Logger, uri, sub string) *Poll {
+	return &Poll{
+		Helper: request.NewHelper(log),
+		uri:    fmt.Sprintf("%s/%s", strings.TrimSuffix(util.DefaultScheme(uri, "http"), "/"), sub),
+	}
+}
+
+// URI returns the polled http api endpoint
+func (p *Poll) URI() string {
+	return p.uri
+}
+
+// StringGetter returns the payload of the http api endpoint, cached for the poll interval
+func (p *Poll) StringGetter() (func() (string, error), error) {
+	return provider.Cached(func() (string, error) {
+		b, err := p.GetBody(p.uri)
+		return string(b), err
+	}, PollInterval), nil
+}
@@ -0,0 +1,95 @@
+package warp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Topics creates the getters and setters of a box's topics shared by the charger generations
+type Topics struct {
+	log      *util.Logger
+	client   *mqtt.Client
+	to       *provider.TimeoutHandler
+	wrap     func(topic string, p provider.StringProvider) provider.StringProvider
+	qos      *byte
+	retained bool
+}
+
+// NewTopics creates the getter and setter factory. Getters are checked for stale data by the timeout handler,
+// wrap is applied to all getter providers, e.g. for polling rejected subscriptions. Both are optional.
+func NewTopics(log *util.Logger, client *mqtt.Client, to *provider.TimeoutHandler, wrap func(string, provider.StringProvider) provider.StringProvider) *Topics {
+	return &Topics{
+		log:    log,
+		client: client,
+		to:     to,
+		wrap:   wrap,
+	}
+}
+
+// WithQoS sets the qos of setters instead of the client qos
+func (t *Topics) WithQoS(qos byte) *Topics {
+	t.qos = &qos
+	return t
+}
+
+// WithRetained publishes setters retained, e.g. for restoring the commanded state after a broker restart
+func (t *Topics) WithRetained(retained bool) *Topics {
+	t.retained = retained
+	return t
+}
+
+// command creates the setter provider of topic
+func (t *Topics) command(topic, payload string) *provider.Mqtt {
+	res := provider.NewMqtt(t.log, t.client, topic, 0).WithPayload(payload).WithRetained(t.retained)
+	if t.qos != nil {
+		res = res.WithQoS(*t.qos)
+	}
+	return res
+}
+
+// provider creates the topic's provider
+func (t *Topics) provider(topic string, dedup time.Duration) provider.StringProvider {
+	var res provider.StringProvider = provider.NewMqtt(t.log, t.client, topic, 0).WithStartupDedup(dedup)
+	if t.wrap != nil {
+		res = t.wrap(topic, res)
+	}
+	return res
+}
+
+// Getter returns the getter of the formatted topic failing on stale data
+func (t *Topics) Getter(format string, args ...any) (func() (string, error), error) {
+	return t.getter(t.provider(fmt.Sprintf(format, args...), 0))
+}
+
+// Frequent returns the getter of a formatted topic published at high rate, e.g. meter values, failing on stale data.
+// Retained messages are often followed by the identical live message at startup, these are ignored within StartupDedup.
+func (t *Topics) Frequent(format string, args ...any) (func() (string, error), error) {
+	return t.getter(t.provider(fmt.Sprintf(format, args...), StartupDedup))
+}
+
+// getter returns the getter of p checked for stale data
+func (t *Topics) getter(p provider.StringProvider) (func() (string, error), error) {
+	if t.to == nil {
+		return p.StringGetter()
+	}
+	return t.to.StringGetter(p)
+}
+
+// Optional returns the getter of a formatted topic not published by all firmware. It is not checked for stale data.
+func (t *Topics) Optional(format string, args ...any) (func() (string, error), error) {
+	return t.provider(fmt.Sprintf(format, args...), 0).StringGetter()
+}
+
+// IntSetter returns the setter publishing the payload with the param replaced to the topic
+func (t *Topics) IntSetter(topic, param, payload string) (func(int64) error, error) {
+	return t.command(topic, payload).IntSetter(param)
+}
+
+// BoolSetter returns the setter publishing the payload with the param replaced to the topic
+func (t *Topics) BoolSetter(topic, param, payload string) (func(bool) error, error) {
+	return t.command(topic, payload).BoolSetter(param)
+}
@@ -1,37 +1,350 @@
 package warp
 
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
 const (
+	FeatureEvse           = "evse" // charge controller and error state
 	FeatureMeter          = "meter"
 	FeatureMeterAllValues = "meter_all_values"
 	FeatureMeterPhases    = "meter_phases"
 	FeatureNfc            = "nfc"
+	FeatureBidirectional  = "bidirectional"  // V2G capable hardware
+	FeatureIso15118       = "iso15118"       // high level communication
+	FeatureEnergyManager  = "energy_manager" // relay and inputs
+	FeaturePhaseSwitch    = "phase_switch"   // phase switching by the evse without energy manager
 )
 
+// Features are the features relevant for decorating chargers
+var Features = []string{
+	FeatureEvse, FeatureMeter, FeatureMeterAllValues, FeatureMeterPhases, FeatureNfc,
+	FeatureBidirectional, FeatureIso15118, FeatureEnergyManager, FeaturePhaseSwitch,
+}
+
+// OverrideFeatures returns the reported features with overrides applied
+func OverrideFeatures(features []string, overrides map[string]bool) []string {
+	res := make([]string, 0, len(features))
+	for _, f := range features {
+		if enabled, ok := overrides[f]; !ok || enabled {
+			res = append(res, f)
+		}
+	}
+
+	for _, f := range Features {
+		if overrides[f] && !slices.Contains(res, f) {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// SameFeatures returns true if both feature lists contain the same features regardless of order
+func SameFeatures(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// https://www.warp-charger.com/api.html#info_name
+type InfoName struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	DisplayType string `json:"display_type"`
+	Uid         string `json:"uid"`
+}
+
+// https://www.warp-charger.com/api.html#info_version
+type Version struct {
+	Firmware string `json:"firmware"`
+	Config   string `json:"config"`
+}
+
+// String returns the firmware version, e.g. "2.4.0-65a0b2c5"
+func (v Version) String() string {
+	return v.Firmware
+}
+
+// Variant returns the hardware variant from the display type, e.g. "WARP2 Charger Pro"
+func (n InfoName) Variant() Variant {
+	fields := strings.Fields(strings.ToLower(n.DisplayType))
+	if len(fields) > 0 {
+		if v := Variant(fields[len(fields)-1]); slices.Contains(variants, v) {
+			return v
+		}
+	}
+	return VariantUnknown
+}
+
+// Variant is the hardware variant of the box
+type Variant string
+
+const (
+	VariantUnknown  Variant = ""
+	VariantSmart    Variant = "smart"
+	VariantPro      Variant = "pro"
+	VariantUltimate Variant = "ultimate"
+)
+
+var variants = []Variant{VariantSmart, VariantPro, VariantUltimate}
+
+func (v Variant) String() string {
+	if v == VariantUnknown {
+		return "unknown"
+	}
+	return string(v)
+}
+
+// Metered returns false if the hardware variant has no built-in meter
+func (v Variant) Metered() bool {
+	return v != VariantSmart
+}
+
+// https://www.warp-charger.com/api.html#info_display_name
+type InfoDisplayName struct {
+	DisplayName string `json:"display_name"`
+}
+
+// FirmwareUpdateState is the maintenance flag published during firmware updates
+type FirmwareUpdateState struct {
+	InProgress bool `json:"in_progress"`
+}
+
+// Iso15118State is the vehicle data obtained via high level communication.
+// Soc is only populated by WARP3 firmware 2.6 and later and only for cars sending
+// DC_EVStatus or SoC in AC charge parameters, which most AC-only cars don't.
+// MaxCurrent is the current negotiated with the car, only populated by firmware 2.7 and later.
+type Iso15118State struct {
+	Soc        *float64 `json:"soc"`
+	MaxCurrent *float64 `json:"ev_max_current"` // A
+}
+
+// https://www.warp-charger.com/api.html#ntp_state
+type NtpState struct {
+	Synced bool  `json:"synced"`
+	Time   int64 `json:"time"` // minutes since epoch
+}
+
+// https://www.warp-charger.com/api.html#ntp_config
+type NtpConfig struct {
+	Enable   bool   `json:"enable"`
+	Timezone string `json:"timezone"`
+}
+
 // https://www.warp-charger.com/api.html#evse_state
 type EvseState struct {
-	Iec61851State          int   `json:"iec61851_state"`
-	ChargerState           int   `json:"charger_state"`
-	ContactorState         int   `json:"contactor_state"`
-	ContactorError         int   `json:"contactor_error"`
-	AllowedChargingCurrent int64 `json:"allowed_charging_current"`
-	ErrorState             int   `json:"error_state"`
-	LockState              int   `json:"lock_state"`
+	Iec61851State          int                  `json:"iec61851_state"`
+	ChargerState           int                  `json:"charger_state"`
+	ContactorState         int                  `json:"contactor_state"`
+	ContactorError         int                  `json:"contactor_error"`
+	AllowedChargingCurrent int64                `json:"allowed_charging_current"`
+	ErrorState             int                  `json:"error_state"`
+	LockState              int                  `json:"lock_state"`
+	DcFaultCurrentState    *DcFaultCurrentState `json:"dc_fault_current_state"` // WARP2 only
+}
+
+// https://www.warp-charger.com/api.html#evse_slots
+type EvseSlot struct {
+	MaxCurrent        int64 `json:"max_current"`
+	Active            bool  `json:"active"`
+	ClearOnDisconnect bool  `json:"clear_on_disconnect"`
+}
+
+// slotNames are the slot names by index. Firmware versions differ in slot count, unknown slots are reported by index.
+var slotNames = []string{
+	"incoming cable", "switch", "interlock input", "shutdown input", "button", "global", "user",
+	"charge manager", "external", "modbus tcp", "modbus tcp enable", "ocpp", "charge limits",
+	"require meter", "automation",
+}
+
+// Slot indices of https://www.warp-charger.com/api.html#evse_slots
+const (
+	SlotIncomingCable = 0  // installer configured supply rating
+	SlotButton        = 4  // blocks charging until started if auto start is disabled
+	SlotUser          = 6  // blocks charging until authorized if user management is enabled
+	SlotChargeManager = 7  // current allocated by the charge manager
+	SlotExternal      = 8  // current controlled by evcc
+	SlotAutomation    = 14 // current set by box schedules
+)
+
+// Slot is a named current limit slot
+type Slot struct {
+	Index   int    `json:"index"`
+	Name    string `json:"name"`
+	Current int64  `json:"current"` // mA
+}
+
+// ActiveSlots returns the active slots. The box applies the minimum current of all active slots.
+func ActiveSlots(slots []EvseSlot) []Slot {
+	var res []Slot
+
+	for i, s := range slots {
+		if !s.Active {
+			continue
+		}
+
+		name := fmt.Sprintf("slot %d", i)
+		if i < len(slotNames) {
+			name = slotNames[i]
+		}
+
+		res = append(res, Slot{Index: i, Name: name, Current: s.MaxCurrent})
+	}
+
+	return res
+}
+
+// LimitingSlot returns the active slot with the lowest current
+func LimitingSlot(slots []Slot) (Slot, bool) {
+	if len(slots) == 0 {
+		return Slot{}, false
+	}
+
+	res := slots[0]
+	for _, s := range slots[1:] {
+		if s.Current < res.Current {
+			res = s
+		}
+	}
+
+	return res, true
+}
+
+// https://www.warp-charger.com/api.html#evse_state error_state
+const (
+	ErrorStateOk            = 0
+	ErrorStateSwitch        = 2
+	ErrorStateCalibration   = 3
+	ErrorStateContactor     = 4
+	ErrorStateCommunication = 5
+)
+
+// EvseProfile is the active named current profile
+type EvseProfile struct {
+	Name string `json:"name"`
+}
+
+// EvseManagementPriority is the load management priority configured for the box within a charge manager group
+type EvseManagementPriority struct {
+	Priority *int `json:"priority"`
+}
+
+// EvseTemperature is the box's internal temperature
+type EvseTemperature struct {
+	Temperature *int `json:"temperature"` // 1/100 °C
+}
+
+// Celsius returns the temperature if the reading is plausible
+func (t EvseTemperature) Celsius() (float64, bool) {
+	if t.Temperature == nil {
+		return 0, false
+	}
+
+	res := float64(*t.Temperature) / 100
+	return res, res >= TemperatureMin && res <= TemperatureMax
+}
+
+// EvseAutoStart is the charge release behaviour. Without auto start, each session must be started at the box.
+type EvseAutoStart struct {
+	AutoStartCharging bool `json:"auto_start_charging"`
 }
 
 type EvseExternalCurrent struct {
 	Current int `json:"current"`
 }
 
+type EvseMinChargingCurrent struct {
+	Current int64 `json:"current"`
+}
+
+//go:generate enumer -type DcFaultCurrentState -trimprefix DcFaultCurrent -transform whitespace
+type DcFaultCurrentState int
+
+const (
+	DcFaultCurrentOk DcFaultCurrentState = iota
+	DcFaultCurrentResidualCurrent
+	DcFaultCurrentSystemError
+	DcFaultCurrentUnknownError
+	DcFaultCurrentCalibrationError
+)
+
 // https://www.warp-charger.com/api.html#evse_low_level_state
 type LowLevelState struct {
 	TimeSinceStateChange int64 `json:"time_since_state_change"`
 	Uptime               int64 `json:"uptime"`
 	LedState             int   `json:"led_state"`
-	CpPwmDutyCycle       int   `json:"cp_pwm_duty_cycle"`
+	CpPwmDutyCycle       *int  `json:"cp_pwm_duty_cycle"` // per mille
 	AdcValues            []int `json:"adc_values"`
 	Voltages             []int
 	Resistances          []int
 	Gpio                 []bool
+	ContactorCycles      *int64 `json:"contactor_cycles"` // newer firmware only
+}
+
+// low level state voltage (mV) and resistance (Ohm) indices
+const (
+	VoltageCpHigh = 1 // control pilot after resistor, pwm high
+	VoltagePp     = 4 // proximity pilot
+	ResistancePp  = 1 // proximity pilot
+)
+
+// Pilot returns control pilot voltage, proximity pilot voltage and resistance if published by the firmware
+func (s LowLevelState) Pilot() (cp, pp, ppResistance int, ok bool) {
+	if len(s.Voltages) <= VoltagePp || len(s.Resistances) <= ResistancePp {
+		return 0, 0, 0, false
+	}
+	return s.Voltages[VoltageCpHigh], s.Voltages[VoltagePp], s.Resistances[ResistancePp], true
+}
+
+// PilotState returns the IEC 61851 state and nominal voltage expected for the control pilot voltage in mV
+func PilotState(mv int) string {
+	switch {
+	case mv > 10500:
+		return "A (12V)"
+	case mv > 7500:
+		return "B (9V)"
+	case mv > 4500:
+		return "C (6V)"
+	case mv > 1500:
+		return "D (3V)"
+	default:
+		return "E/F (0V/-12V)"
+	}
+}
+
+// DutyCycleCurrent returns the current in A offered by the control pilot duty cycle in per mille according to IEC 61851
+func DutyCycleCurrent(permille int) float64 {
+	switch {
+	case permille < 80 || permille > 970:
+		return 0
+	case permille <= 850:
+		return float64(permille) * 0.06
+	default:
+		return float64(permille-640) * 0.25
+	}
+}
+
+// CableRating returns the cable current rating in A for the proximity pilot resistance in Ohm
+func CableRating(ohm int) (int, bool) {
+	switch {
+	case ohm >= 75 && ohm < 150: // 100 Ohm
+		return 63, true
+	case ohm >= 150 && ohm < 450: // 220 Ohm
+		return 32, true
+	case ohm >= 450 && ohm < 1100: // 680 Ohm
+		return 20, true
+	case ohm >= 1100 && ohm < 2700: // 1.5 kOhm
+		return 13, true
+	default:
+		return 0, false
+	}
 }
 
 // https://www.warp-charger.com/api.html#meter_state
@@ -42,22 +355,99 @@ type MeterState struct {
 // https://www.warp-charger.com/api.html#meter_values
 type MeterValues struct {
 	Power           float64 `json:"power"`
-	EnergyRel       float64 `json:"energy_rel"`
-	EnergyAbs       float64 `json:"energy_abs"`
+	EnergyRel       float64 `json:"energy_rel" alt:"energy_relative"`
+	EnergyAbs       float64 `json:"energy_abs" alt:"energy_absolute"`
 	PhasesActive    []bool  `json:"phases_active"`
 	PhasesConnected []bool  `json:"phases_connected"`
 }
 
+// VoltageDeviation returns the deviation of voltage u from the nominal voltage in percent
+func VoltageDeviation(u, nominal float64) float64 {
+	return (u - nominal) / nominal * 100
+}
+
+// MeterTopic returns the meter subtree for the meter slot. Slot 0 is the box meter
+// published below the legacy meter topic, further slots are only published by firmware
+// supporting multiple meters.
+func MeterTopic(slot int) string {
+	if slot == 0 {
+		return "meter"
+	}
+	return fmt.Sprintf("meters/%d", slot)
+}
+
+// https://docs.warp-charger.com/docs/mqtt_http/api_reference/meters value ids
+const (
+	ValueIdVoltageL1L2 = 4   // line-to-line voltage
+	ValueIdVoltageL2L3 = 5   // line-to-line voltage
+	ValueIdVoltageL3L1 = 6   // line-to-line voltage
+	ValueIdEnergyL1    = 210 // imported energy
+	ValueIdEnergyL2    = 211 // imported energy
+	ValueIdEnergyL3    = 212 // imported energy
+	ValueIdPowerFactor = 353 // power factor, sum of phases, directional
+	ValueIdFrequency   = 364 // frequency, average of phases
+)
+
+// ValueIndex returns the position of the value id in the all_values array
+func ValueIndex(ids []int, id int) (int, bool) {
+	for i, v := range ids {
+		if v == id {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 // https://www.warp-charger.com/api.html#meter_all_values
 type MeterAllValues struct {
 	PhasesActive    []bool `json:"phases_active"`
 	PhasesConnected []bool `json:"phases_connected"`
 }
 
+// MeterPhase is a phase reading of the WARP3 phases topic
+type MeterPhase struct {
+	Phase   int     `json:"phase"`   // 1-3
+	Voltage float64 `json:"voltage"` // V
+	Current float64 `json:"current"` // A, magnitude
+	Power   float64 `json:"power"`   // W, negative when discharging
+}
+
+// PhaseMeter is the WARP3 per phase meter reading ordered L1-L3
+type PhaseMeter [3]MeterPhase
+
+// NewPhaseMeter orders the phase readings by reported phase as boxes publish them in measurement order
+func NewPhaseMeter(phases []MeterPhase) (PhaseMeter, error) {
+	var res PhaseMeter
+
+	if len(phases) != len(res) {
+		return res, fmt.Errorf("invalid phase count: %d", len(phases))
+	}
+
+	for _, p := range phases {
+		if p.Phase < 1 || p.Phase > len(res) || res[p.Phase-1].Phase != 0 {
+			return res, fmt.Errorf("invalid phase: %d", p.Phase)
+		}
+		res[p.Phase-1] = p
+	}
+
+	return res, nil
+}
+
 type UsersConfig struct {
 	Users []User `json:"users"`
 }
 
+// https://www.warp-charger.com/api.html#charge_tracker_current_charge authorization_type
+const (
+	AuthorizationNone      = 0
+	AuthorizationLost      = 1
+	AuthorizationNfc       = 2
+	AuthorizationInjection = 3
+)
+
+// UserNone is the charge tracker user id while no charge is in progress
+const UserNone = -1
+
 type ChargeTrackerCurrentCharge struct {
 	UserID            int     `json:"user_id"`
 	MeterStart        float64 `json:"meter_start"`
@@ -76,17 +466,141 @@ type User struct {
 	UserName    string `json:"username"`
 }
 
+// UserUnknown is the user id of charges started without authorization
+const UserUnknown = 0
+
+// LastCharge is a completed charge of https://www.warp-charger.com/api.html#charge_tracker_last_charges
+type LastCharge struct {
+	TimestampMinutes int64    `json:"timestamp_minutes"`
+	ChargeDuration   int64    `json:"charge_duration"` // s
+	UserID           int      `json:"user_id"`
+	EnergyCharged    *float64 `json:"energy_charged"` // kWh, null without meter
+}
+
+// UserStats is the energy charged by a user
+type UserStats struct {
+	UserID  int
+	Name    string
+	Charges int
+	Energy  float64 // kWh
+}
+
+// UserEnergies sums the charged energy per user ordered by user id. Configured users without charges are included.
+// Charges of users deleted from the configuration are kept under their id as the box doesn't reassign ids.
+func UserEnergies(users []User, charges []LastCharge) []UserStats {
+	stats := make(map[int]*UserStats)
+
+	stat := func(id int) *UserStats {
+		if s, ok := stats[id]; ok {
+			return s
+		}
+		s := &UserStats{UserID: id, Name: fmt.Sprintf("deleted user %d", id)}
+		if id == UserUnknown {
+			s.Name = "unknown user"
+		}
+		stats[id] = s
+		return s
+	}
+
+	for _, u := range users {
+		s := stat(u.ID)
+		if u.DisplayName != "" {
+			s.Name = u.DisplayName
+		} else if u.UserName != "" {
+			s.Name = u.UserName
+		}
+	}
+
+	for _, c := range charges {
+		s := stat(c.UserID)
+		s.Charges++
+		if c.EnergyCharged != nil {
+			s.Energy += *c.EnergyCharged
+		}
+	}
+
+	res := make([]UserStats, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, *s)
+	}
+
+	slices.SortFunc(res, func(a, b UserStats) int {
+		return a.UserID - b.UserID
+	})
+
+	return res
+}
+
 type LastNfcTag struct {
 	UserID int    `json:"user_id"`
 	Type   int    `json:"tag_type"`
 	ID     string `json:"tag_id"`
 }
 
+// NfcConfig is the box's nfc configuration. Only the authorized tags are decoded for confirming reloads.
+type NfcConfig struct {
+	AuthorizedTags []NfcTag `json:"authorized_tags"`
+}
+
+type NfcTag struct {
+	UserID  int    `json:"user_id"`
+	TagType int    `json:"tag_type"`
+	TagId   string `json:"tag_id"`
+}
+
 type EmConfig struct {
 	ContactorInstalled bool `json:"contactor_installed"`
 	PhaseSwitchingMode int  `json:"phase_switching_mode"`
 }
 
+//go:generate enumer -type NotCharging -trimprefix NotCharging -transform whitespace
+type NotCharging int
+
+// reasons for a connected vehicle not charging
+const (
+	NotChargingNone          NotCharging = iota // charging or no vehicle connected
+	NotChargingDisabled                         // disabled by evcc
+	NotChargingAuthorization                    // awaiting nfc authorization
+	NotChargingRelease                          // awaiting charge release at the box
+	NotChargingLimit                            // blocked by another current limit
+	NotChargingFault                            // box fault
+	NotChargingVehicle                          // current offered, vehicle not ready
+)
+
+// NotChargingReason returns why a vehicle with given status is not charging. The slots blocking the current
+// take precedence over the evcc enable state.
+func NotChargingReason(status int, fault, disabled bool, slots []Slot) NotCharging {
+	switch {
+	case fault:
+		return NotChargingFault
+	case status != 1: // vehicle connected
+		return NotChargingNone
+	}
+
+	for _, s := range slots {
+		if s.Current > 0 {
+			continue
+		}
+
+		switch s.Index {
+		case SlotUser:
+			return NotChargingAuthorization
+		case SlotButton:
+			return NotChargingRelease
+		case SlotExternal:
+			// evcc enable state
+		default:
+			return NotChargingLimit
+		}
+	}
+
+	if disabled {
+		return NotChargingDisabled
+	}
+
+	return NotChargingVehicle
+}
+
 //go:generate enumer -type ExternalControl -trimprefix ExternalControl -transform whitespace
 type ExternalControl int
 
@@ -97,6 +611,37 @@ const (
 	ExternalControlCurrentlySwitching
 )
 
+// Guidance returns the action required on the energy manager to make phase switching by external control available
+func (c ExternalControl) Guidance() string {
+	switch c {
+	case ExternalControlDeactivated:
+		return `enable "External control" as phase switching mode in the energy manager's settings`
+	case ExternalControlRuntimeConditionsNotMet:
+		return "energy manager runtime conditions not met, check contactor and phase switching setup in the energy manager's status"
+	case ExternalControlCurrentlySwitching:
+		return "energy manager is currently switching phases"
+	default:
+		return ""
+	}
+}
+
+// Price is the energy price shown on the box front panel
+type Price struct {
+	Price    int64  `json:"price"`    // 1/1000 of the minor currency unit per kWh, e.g. millicent
+	Currency string `json:"currency"` // ISO 4217
+}
+
+// DisplayPrice converts the price per kWh to the box's integer price in 1/1000 of the minor currency unit
+// with digits decimal places
+func DisplayPrice(price float64, digits int) int64 {
+	return int64(math.Round(price * math.Pow10(digits+3)))
+}
+
+// https://www.warp-charger.com/api.html#info_keep_alive
+type KeepAlive struct {
+	Uptime int64 `json:"uptime"` // ms
+}
+
 type EmState struct {
 	ExternalControl ExternalControl `json:"external_control"`
 	PhasesSwitched  int             `json:"phases_switched"`
@@ -105,3 +650,68 @@ type EmState struct {
 	RelayState      bool            `json:"relay_state"`
 	ErrorFlags      int             `json:"error_flags"`
 }
+
+// https://www.warp-charger.com/api.html#automation_config trigger types
+const AutomationTriggerCron = 1
+
+// AutomationConfig are the box's automation tasks
+type AutomationConfig struct {
+	Tasks []json.RawMessage `json:"tasks"`
+}
+
+// Schedules returns the time triggered tasks
+func (c AutomationConfig) Schedules() []json.RawMessage {
+	var res []json.RawMessage
+
+	for _, t := range c.Tasks {
+		var task struct {
+			Trigger []json.RawMessage `json:"trigger"`
+		}
+
+		var trigger int
+		if json.Unmarshal(t, &task) == nil && len(task.Trigger) > 0 && json.Unmarshal(task.Trigger[0], &trigger) == nil && trigger == AutomationTriggerCron {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
+
+// Monitor is the heartbeat published for external monitoring while evcc controls the box
+type Monitor struct {
+	Version      string  `json:"version,omitempty"` // not yet known while starting
+	Mode         string  `json:"mode,omitempty"`
+	Current      float64 `json:"current"`       // A, last requested current
+	SetpointTime int64   `json:"setpoint_time"` // unix time of last requested current
+	Timestamp    int64   `json:"timestamp"`
+}
+
+// EventLogEntry is a single event log line
+type EventLogEntry struct {
+	Time    time.Time // zero if the box had no time sync when logging
+	Message string
+}
+
+const eventLogTime = "2006-01-02 15:04:05,000"
+
+// ParseEventLog parses the event log. Lines are prefixed by local time or, without time sync, by uptime.
+func ParseEventLog(s string) []EventLogEntry {
+	var res []EventLogEntry
+
+	for _, line := range strings.Split(s, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		e := EventLogEntry{Message: strings.TrimSpace(line)}
+		if len(line) > len(eventLogTime) {
+			if ts, err := time.ParseInLocation(eventLogTime, line[:len(eventLogTime)], time.Local); err == nil {
+				e = EventLogEntry{Time: ts, Message: strings.TrimSpace(line[len(eventLogTime):])}
+			}
+		}
+
+		res = append(res, e)
+	}
+
+	return res
+}
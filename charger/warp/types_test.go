@@ -0,0 +1,149 @@
+package warp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVariant(t *testing.T) {
+	for _, tc := range []struct {
+		displayType string
+		expected    Variant
+	}{
+		{"WARP2 Charger Smart", VariantSmart},
+		{"WARP2 Charger Pro", VariantPro},
+		{"WARP3 Charger Pro", VariantPro},
+		{"WARP Energy Manager", VariantUnknown},
+		{"", VariantUnknown},
+	} {
+		assert.Equal(t, tc.expected, InfoName{DisplayType: tc.displayType}.Variant(), tc.displayType)
+	}
+
+	assert.False(t, VariantSmart.Metered())
+	assert.True(t, VariantUnknown.Metered())
+}
+
+func TestPilot(t *testing.T) {
+	_, _, _, ok := LowLevelState{}.Pilot()
+	assert.False(t, ok)
+
+	cp, pp, ppResistance, ok := LowLevelState{
+		Voltages:    []int{8950, 8870, -11900, -11950, 2850},
+		Resistances: []int{2700, 220},
+	}.Pilot()
+	assert.True(t, ok)
+	assert.Equal(t, []int{8870, 2850, 220}, []int{cp, pp, ppResistance})
+
+	assert.Equal(t, "B (9V)", PilotState(cp))
+
+	rating, ok := CableRating(ppResistance)
+	assert.True(t, ok)
+	assert.Equal(t, 32, rating)
+
+	assert.Equal(t, 6.0, DutyCycleCurrent(100))
+	assert.Equal(t, 0.0, DutyCycleCurrent(1000))
+}
+
+func TestMeterTopic(t *testing.T) {
+	assert.Equal(t, "meter", MeterTopic(0))
+	assert.Equal(t, "meters/1", MeterTopic(1))
+}
+
+func TestNewPhaseMeter(t *testing.T) {
+	res, err := NewPhaseMeter([]MeterPhase{{Phase: 3, Current: 3}, {Phase: 1, Current: 1}, {Phase: 2, Current: 2}})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, []float64{res[0].Current, res[1].Current, res[2].Current})
+
+	_, err = NewPhaseMeter([]MeterPhase{{Phase: 1}, {Phase: 1}, {Phase: 2}})
+	assert.Error(t, err)
+
+	_, err = NewPhaseMeter([]MeterPhase{{Phase: 1}, {Phase: 2}})
+	assert.Error(t, err)
+}
+
+func TestExternalControlGuidance(t *testing.T) {
+	assert.Empty(t, ExternalControlAvailable.Guidance())
+	assert.Contains(t, ExternalControlDeactivated.Guidance(), "External control")
+}
+
+func TestOverrideFeatures(t *testing.T) {
+	reported := []string{FeatureNfc, FeatureMeter}
+
+	// trust reported features by default
+	assert.Equal(t, reported, OverrideFeatures(reported, nil))
+
+	assert.Equal(t, []string{FeatureNfc, FeatureMeter, FeatureMeterPhases},
+		OverrideFeatures(reported, map[string]bool{FeatureMeterPhases: true, FeatureMeter: true}))
+
+	assert.Equal(t, []string{FeatureMeter},
+		OverrideFeatures(reported, map[string]bool{FeatureNfc: false}))
+}
+
+func TestNotChargingReason(t *testing.T) {
+	offered := []Slot{{Index: 0, Current: 32000}, {Index: SlotExternal, Current: 16000}}
+	external := []Slot{{Index: 0, Current: 32000}, {Index: SlotExternal, Current: 0}}
+
+	for _, tc := range []struct {
+		status   int
+		fault    bool
+		disabled bool
+		slots    []Slot
+		expected NotCharging
+	}{
+		{0, false, false, offered, NotChargingNone},
+		{2, false, false, offered, NotChargingNone},
+		{1, false, true, external, NotChargingDisabled},
+		{1, false, true, []Slot{{Index: SlotUser, Current: 0}, {Index: SlotExternal, Current: 0}}, NotChargingAuthorization},
+		{1, false, false, []Slot{{Index: SlotButton, Current: 0}}, NotChargingRelease},
+		{1, false, false, []Slot{{Index: SlotChargeManager, Current: 0}}, NotChargingLimit},
+		{4, true, false, offered, NotChargingFault},
+		{1, false, false, offered, NotChargingVehicle},
+	} {
+		assert.Equal(t, tc.expected, NotChargingReason(tc.status, tc.fault, tc.disabled, tc.slots), tc)
+	}
+}
+
+func TestAutomationSchedules(t *testing.T) {
+	var res AutomationConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"tasks":[
+		{"trigger":[1,{"mday":-1,"wday":-1,"hour":0,"minute":0}],"action":[3,{"current":16000}]},
+		{"trigger":[5,{}],"action":[2,{}]}
+	]}`), &res))
+
+	schedules := res.Schedules()
+	require.Len(t, schedules, 1)
+	assert.JSONEq(t, `{"trigger":[1,{"mday":-1,"wday":-1,"hour":0,"minute":0}],"action":[3,{"current":16000}]}`, string(schedules[0]))
+}
+
+func TestUserEnergies(t *testing.T) {
+	energy := func(f float64) *float64 { return &f }
+
+	users := []User{
+		{ID: 0, DisplayName: "Anonymous"},
+		{ID: 1, DisplayName: "Alice"},
+		{ID: 3, UserName: "bob"},
+		{ID: 4, DisplayName: "Carol"}, // added, no charges yet
+	}
+
+	charges := []LastCharge{
+		{UserID: 1, EnergyCharged: energy(10.5)},
+		{UserID: 0, EnergyCharged: energy(2)},
+		{UserID: 2, EnergyCharged: energy(7)}, // deleted user
+		{UserID: 1, EnergyCharged: energy(4.5)},
+		{UserID: 3}, // no meter
+	}
+
+	assert.Equal(t, []UserStats{
+		{UserID: 0, Name: "Anonymous", Charges: 1, Energy: 2},
+		{UserID: 1, Name: "Alice", Charges: 2, Energy: 15},
+		{UserID: 2, Name: "deleted user 2", Charges: 1, Energy: 7},
+		{UserID: 3, Name: "bob", Charges: 1},
+		{UserID: 4, Name: "Carol"},
+	}, UserEnergies(users, charges))
+
+	// unknown user without configuration
+	assert.Equal(t, []UserStats{{UserID: 0, Name: "unknown user", Charges: 1, Energy: 1}}, UserEnergies(nil, []LastCharge{{EnergyCharged: energy(1)}}))
+}
@@ -0,0 +1,224 @@
+package warp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/request"
+	"nhooyr.io/websocket"
+)
+
+// WebsocketRetry is the delay before reconnecting a dropped websocket
+var WebsocketRetry = 5 * time.Second
+
+// event is a state change pushed by the box's websocket
+type event struct {
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Websocket implements the paho.Client interface for the box's websocket event stream. Events are delivered
+// below the root topic like the box's mqtt messages, the last event per topic is retained and delivered on
+// subscription. Commands are sent using the http api.
+type Websocket struct {
+	*request.Helper
+	log      *util.Logger
+	uri      string
+	root     string
+	mu       sync.Mutex
+	conn     bool
+	handlers map[string]paho.MessageHandler
+	retained map[string]string
+	onLost   paho.ConnectionLostHandler
+	onConn   paho.OnConnectHandler
+}
+
+var _ paho.Client = (*Websocket)(nil)
+
+// NewWebsocketClient creates a client receiving the box's state by websocket
+func NewWebsocketClient(log *util.Logger, uri, root string) *mqtt.Client {
+	uri = strings.TrimSuffix(util.DefaultScheme(uri, "http"), "/")
+
+	ws := &Websocket{
+		Helper:   request.NewHelper(log),
+		log:      log,
+		uri:      uri,
+		root:     root,
+		handlers: make(map[string]paho.MessageHandler),
+		retained: make(map[string]string),
+	}
+
+	client := mqtt.NewAdapterClient(log, ws.socket(), ws)
+
+	// notify connection observers and restore listeners like for broker connections
+	ws.onLost = client.ConnectionLostHandler
+	ws.onConn = client.ConnectionHandler
+
+	go ws.run()
+
+	return client
+}
+
+// socket returns the websocket uri
+func (ws *Websocket) socket() string {
+	uri := strings.Replace(ws.uri, "http", "ws", 1)
+	return uri + "/ws"
+}
+
+func (ws *Websocket) run() {
+	for {
+		err := ws.listen()
+
+		ws.mu.Lock()
+		connected := ws.conn
+		ws.conn = false
+		ws.mu.Unlock()
+
+		if connected {
+			ws.onLost(ws, err)
+		} else {
+			ws.log.ERROR.Printf("websocket: %v", err)
+		}
+
+		time.Sleep(WebsocketRetry)
+	}
+}
+
+// listen receives events until the websocket is dropped
+func (ws *Websocket) listen() error {
+	ctx, cancel := context.WithTimeout(context.Background(), request.Timeout)
+	conn, _, err := websocket.Dial(ctx, ws.socket(), nil)
+	cancel()
+	if err != nil {
+		return err
+	}
+	defer conn.Close(websocket.StatusAbnormalClosure, "done")
+
+	// events can exceed the default read limit, e.g. meter values
+	conn.SetReadLimit(1 << 20)
+
+	ws.mu.Lock()
+	ws.conn = true
+	ws.mu.Unlock()
+
+	ws.onConn(ws)
+
+	for {
+		_, b, err := conn.Read(context.Background())
+		if err != nil {
+			return err
+		}
+
+		// a message may contain multiple newline separated events
+		scanner := bufio.NewScanner(bytes.NewReader(b))
+		scanner.Buffer(nil, len(b)+1)
+
+		for scanner.Scan() {
+			if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+				continue
+			}
+
+			var ev event
+			if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+				ws.log.DEBUG.Printf("websocket: %v", err)
+				continue
+			}
+
+			ws.receive(ev)
+		}
+	}
+}
+
+func (ws *Websocket) receive(ev event) {
+	topic := fmt.Sprintf("%s/%s", ws.root, ev.Topic)
+	payload := string(ev.Payload)
+
+	ws.mu.Lock()
+	ws.retained[topic] = payload
+	h := ws.handlers[topic]
+	ws.mu.Unlock()
+
+	if h != nil {
+		h(ws, mqtt.NewMessage(topic, payload, false))
+	}
+}
+
+func (ws *Websocket) IsConnected() bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.conn
+}
+
+func (ws *Websocket) IsConnectionOpen() bool { return ws.IsConnected() }
+
+func (ws *Websocket) Connect() paho.Token { return mqtt.Done(nil) }
+func (ws *Websocket) Disconnect(uint)     {}
+
+// Publish sends the command using the http api
+func (ws *Websocket) Publish(topic string, _ byte, _ bool, payload interface{}) paho.Token {
+	sub, ok := strings.CutPrefix(topic, ws.root+"/")
+	if !ok {
+		return mqtt.Done(fmt.Errorf("invalid topic: %s", topic))
+	}
+
+	req, err := request.New(http.MethodPut, fmt.Sprintf("%s/%s", ws.uri, sub), strings.NewReader(fmt.Sprintf("%v", payload)), request.JSONEncoding)
+	if err == nil {
+		_, err = ws.DoBody(req)
+	}
+
+	return mqtt.Done(err)
+}
+
+// Subscribe replaces the handler of topic, the retained event is delivered immediately. Without callback the
+// handler added by route is kept.
+func (ws *Websocket) Subscribe(topic string, _ byte, callback paho.MessageHandler) paho.Token {
+	ws.mu.Lock()
+	if callback != nil {
+		ws.handlers[topic] = callback
+	}
+	callback = ws.handlers[topic]
+	payload, ok := ws.retained[topic]
+	ws.mu.Unlock()
+
+	if ok && callback != nil {
+		go callback(ws, mqtt.NewMessage(topic, payload, true))
+	}
+
+	return mqtt.Done(nil)
+}
+
+func (ws *Websocket) SubscribeMultiple(filters map[string]byte, callback paho.MessageHandler) paho.Token {
+	for topic := range filters {
+		ws.Subscribe(topic, 0, callback)
+	}
+	return mqtt.Done(nil)
+}
+
+func (ws *Websocket) Unsubscribe(topics ...string) paho.Token {
+	ws.mu.Lock()
+	for _, topic := range topics {
+		delete(ws.handlers, topic)
+	}
+	ws.mu.Unlock()
+	return mqtt.Done(nil)
+}
+
+func (ws *Websocket) AddRoute(topic string, callback paho.MessageHandler) {
+	ws.mu.Lock()
+	ws.handlers[topic] = callback
+	ws.mu.Unlock()
+}
+
+func (ws *Websocket) OptionsReader() paho.ClientOptionsReader {
+	return paho.ClientOptionsReader{}
+}
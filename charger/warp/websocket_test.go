@@ -0,0 +1,92 @@
+package warp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+)
+
+func TestWebsocket(t *testing.T) {
+	WebsocketRetry = 10 * time.Millisecond
+
+	var connects atomic.Int32
+	command := make(chan string, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		msg := `{"topic":"evse/state","payload":{"iec61851_state":1}}` + "\n" + `{"topic":"evse/external_current","payload":{"current":6000}}`
+		if connects.Add(1) > 1 {
+			msg = `{"topic":"evse/state","payload":{"iec61851_state":2}}`
+		}
+
+		_ = conn.Write(context.Background(), websocket.MessageText, []byte(msg))
+
+		// dropped connection
+		conn.Close(websocket.StatusGoingAway, "")
+	})
+	mux.HandleFunc("/evse/external_current_update", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		command <- r.Method + " " + string(b)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := NewWebsocketClient(util.NewLogger("foo"), srv.URL, "warp")
+
+	changes := make(chan bool, 10)
+	client.OnConnectionChange(func(connected bool) {
+		select {
+		case changes <- connected:
+		default:
+		}
+	})
+
+	recv := make(chan string, 10)
+	require.NoError(t, client.Listen("warp/evse/state", func(payload string) {
+		recv <- payload
+	}))
+
+	var res []string
+	for len(res) == 0 || res[len(res)-1] != `{"iec61851_state":2}` {
+		select {
+		case payload := <-recv:
+			res = append(res, payload)
+		case <-time.After(time.Second):
+			t.Fatal("timeout", res)
+		}
+	}
+
+	// reconnected after drop
+	assert.Contains(t, res, `{"iec61851_state":1}`)
+	assert.GreaterOrEqual(t, connects.Load(), int32(2))
+
+	// observers notified of drop and reconnect
+	var seen []bool
+	for !slices.Contains(seen, false) || !slices.Contains(seen[slices.Index(seen, false):], true) {
+		select {
+		case connected := <-changes:
+			seen = append(seen, connected)
+		case <-time.After(time.Second):
+			t.Fatal("timeout", seen)
+		}
+	}
+
+	require.NoError(t, client.Publish("warp/evse/external_current_update", false, `{ "current": 16000 }`))
+	assert.Equal(t, `PUT { "current": 16000 }`, <-command)
+}
@@ -4,339 +4,3151 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"os"
+	"path"
 	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/charger/warp"
+	"github.com/evcc-io/evcc/cmd/shutdown"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/meter"
 	"github.com/evcc-io/evcc/provider"
 	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/server/db/settings"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/evcc-io/evcc/util/request"
 )
 
 // Warp2 is the Warp charger v2 firmware implementation
 type Warp2 struct {
-	log           *util.Logger
-	client        *mqtt.Client
-	features      []string
-	maxcurrentG   func() (string, error)
-	statusG       func() (string, error)
-	meterG        func() (string, error)
-	meterDetailsG func() (string, error)
-	chargeG       func() (string, error)
-	userconfigG   func() (string, error)
-	emStateG      func() (string, error)
-	maxcurrentS   func(int64) error
-	phasesS       func(int64) error
-	current       int64
+	log               *util.Logger
+	mu                sync.Mutex
+	lp                loadpoint.API
+	client            *mqtt.Client
+	writer            *mqtt.Client
+	commandQos        byte
+	retained          bool
+	root              string
+	emTopic           string
+	evsePhases        bool // phase switching by evse instead of energy manager
+	timeout           time.Duration
+	to                *provider.TimeoutHandler
+	invertPower       bool
+	shared            bool
+	features          []string
+	featureCache      bool
+	featureRefresh    sync.Once
+	firmware          *warp.Version
+	featureOverrides  map[string]bool
+	variants          sync.Map // alternate field names in use
+	topics            *warp.Topics
+	lowLevelG         func() (string, error)
+	maxcurrentG       func() (string, error)
+	mincurrentG       func() (string, error)
+	statusG           func() (string, error)
+	slotsG            func() (string, error)
+	profileG          func() (string, error)
+	priorityG         func() (string, error)
+	autoStartG        func() (string, error)
+	meterG            func() (string, error)
+	meterDetailsG     func() (string, error)
+	valueIdsG         func() (string, error)
+	phasesG           func() (string, error) // warp3 only
+	temperatureG      atomic.Pointer[func() (string, error)]
+	chargeG           func() (string, error)
+	userconfigG       func() (string, error)
+	emStateG          func() (string, error)
+	iso15118G         func() (string, error)
+	maxcurrentS       func(int64) error
+	phasesS           func(int64) error
+	externalEnabledS  func(bool) error
+	signedCurrentS    func(int64) error
+	stopS             func() error
+	startS            func() error
+	current           int64
+	resolution        int64
+	gridLimit         int64
+	signedCurrents    bool
+	phaseSwitching    bool
+	bidirectional     bool
+	iso15118          bool
+	nfc               bool
+	offlineGrace      time.Duration
+	pausedCurrent     float64
+	lineVoltages      bool
+	holdPhases        bool
+	singlePhase       bool
+	currentTolerance  float64
+	uptime            int64
+	cadence           *warp.Cadence
+	nominalVoltage    float64
+	meterVoltages     bool
+	voltageSag        float64
+	stopCharging      bool
+	tagId             string
+	dcFault           warp.DcFaultCurrentState
+	lastStatus        api.ChargeStatus
+	lastStatusUpdated time.Time
+	requested         api.Setpoint
+	published         publishedCurrent
+	confirmed         api.Setpoint
+	phaseCommand      phaseCommand
+	meterWatch        meterWatch
+	nfcConfig         nfcConfig
+	transitions       transitions
+	errorClear        errorClear
+	hold              disableHold
+	resetS            func() error
+	host              string
+	version           string
+	yieldConflict     bool
+	conflict          bool
+	updating          atomic.Bool
+	stalled           atomic.Bool
+	disconnected      atomic.Bool
+	pings             pinger
+	display           display
+	checks            checks
+
+	emStatePayload string
+	emStateCache   warp.EmState
+
+	configMu sync.Mutex
+	configs  map[string]*util.Monitor[string]
 }
 
 func init() {
 	registry.Add("warp2", NewWarp2FromConfig)
-	registry.Add("warp-fw2", NewWarp2FromConfig) // deprecated
+	registry.Add("warp-fw2", NewWarpFw2FromConfig) // deprecated
 }
 
-//go:generate go run ../cmd/tools/decorate.go -f decorateWarp2 -b *Warp2 -r api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.Identifier,Identify,func() (string, error)" -t "api.PhaseSwitcher,Phases1p3p,func(int) error"
+//go:generate go run ../cmd/tools/decorate.go -f decorateWarp2 -b *Warp2 -r api.Charger -t "api.Meter,CurrentPower,func() (float64, error)" -t "api.MeterEnergy,TotalEnergy,func() (float64, error)" -t "api.PhaseCurrents,Currents,func() (float64, float64, float64, error)" -t "api.PhaseVoltages,Voltages,func() (float64, float64, float64, error)" -t "api.Identifier,Identify,func() (string, error)" -t "api.PhaseSwitcher,Phases1p3p,func(int) error" -t "api.ChargeRater,ChargedEnergy,func() (float64, error)" -t "api.Battery,Soc,func() (float64, error)" -t "api.VehicleCurrentGetter,GetVehicleCurrent,func() (float64, error)" -t "api.PhaseGetter,GetPhases,func() (int, error)" -t "api.PhaseEnergies,Energies,func() (float64, float64, float64, error)" -t "api.AuthorizationStatus,Authorized,func() (bool, error)" -t "api.PriceDisplay,SetPrice,func(float64, string) error" -t "api.FaultClassifier,TransientFault,func() (bool, error)" -w "api.MeterEnergy=api.Meter" -w "api.ChargeRater=api.Meter" -w "api.PhaseVoltages=api.PhaseCurrents" -w "api.VehicleCurrentGetter=api.Battery" -w "api.PhaseGetter=api.PhaseSwitcher" -w "api.PhaseEnergies=api.PhaseCurrents" -w "api.AuthorizationStatus=api.Identifier"
 
 // NewWarpFromConfig creates a new configurable charger
 func NewWarp2FromConfig(other map[string]interface{}) (api.Charger, error) {
+	return newWarp2FromConfig(other, false)
+}
+
+// newWarp2FromConfig creates the charger from config shared by warp2 and warp3. The phase meter
+// maps values by the phases topic instead of the position in all values.
+func newWarp2FromConfig(other map[string]interface{}, phaseMeter bool) (api.Charger, error) {
 	cc := struct {
-		mqtt.Config   `mapstructure:",squash"`
-		Topic         string
-		EnergyManager string
-		Timeout       time.Duration
+		mqtt.Config       `mapstructure:",squash"`
+		Topic             string
+		EnergyManager     string
+		Timeout           time.Duration
+		StaleGrace        time.Duration // serve last values after timeout before failing
+		CurrentResolution int64         // mA
+		DisplayName       displayNameSettings
+		Shutdown          string // hold or release
+		Heartbeat         bool   // blink indicator led while in control
+		Monitor           monitorSettings
+		Checks            checkSettings
+		MeterMissing      meterMissingSettings
+		ErrorClear        errorClearSettings
+		Disable           disableHoldSettings
+		NfcConflict       string          // warn or yield
+		OfflineGrace      time.Duration   // hold last status on getter timeouts
+		ContactorCycles   int64           // warn when contactor cycle count exceeds
+		GridLimit         float64         // A, hard cap for the requested current
+		SignedCurrents    bool            // negative phase currents when discharging
+		PublishInterval   time.Duration   // minimum interval between current and phase increases
+		PausedCurrent     float64         // A, report charging at lower phase currents as paused after disabling
+		StopCharging      bool            // end the session by stop command when disabled
+		StartupWait       time.Duration   // fail if critical topics receive no data
+		EnableCurrent     float64         // A, applied when enabling before any current was set
+		LineVoltages      bool            // report line-to-line voltages for delta systems without neutral
+		PublishSoc        bool            // show vehicle soc on the box front panel
+		PublishPrice      bool            // show grid tariff price on the box front panel
+		Temperature       bool            // report the box temperature if published by the firmware
+		PowerSource       string          // total or phases
+		PowerSign         string          // import or export, direction of positive meter power
+		HoldPhases        bool            // defer phase switching while charging
+		MeterName         string          // register box meter as site meter
+		MeterSlot         int             // meter slot for boxes with multiple meters
+		Phases            int             // 1 for installations with single phase supply
+		CurrentTolerance  float64         // A, warn if measured current exceeds the set current while charging
+		NominalVoltage    float64         // V, phase voltage for deviation reporting and power estimation
+		VoltageSag        float64         // %, warn if a phase voltage drops further below nominal
+		Keepalive         time.Duration   // broker round-trip check interval
+		AdaptiveTimeout   bool            // stale after a multiple of the observed update interval, limited by timeout
+		Features          map[string]bool // force enable or disable reported features
+		FeatureCache      bool            // start with the features of the previous run, refreshed in background
+		Minimal           bool            // status and current only, see newWarp2
+		Transport         string          // mqtt or ws
+		Host              string          // box address for ws transport and http polling of rejected subscriptions
+		Write             mqtt.Config     // separate broker for commands
+		Qos               *byte           // command qos, defaults to 1
+		Retained          bool            // publish current and phase commands retained
+		UseMeter          *bool           // fw1 only
 	}{
-		Topic:   warp.RootTopic,
-		Timeout: warp.Timeout,
+		Topic:             warp.RootTopic,
+		Timeout:           warp.Timeout,
+		CurrentResolution: 1,
+		Shutdown:          warp.ShutdownHold,
+		NfcConflict:       warp.ConflictWarn,
+		PowerSource:       warp.PowerTotal,
+		PowerSign:         warp.PowerImport,
+		Transport:         warp.TransportMqtt,
+		NominalVoltage:    warp.NominalVoltage,
+		VoltageSag:        warp.VoltageSag,
+		MeterMissing: meterMissingSettings{
+			Timeout: warp.MeterMissing,
+		},
+		ErrorClear: errorClearSettings{
+			Attempts: warp.ClearAttempts,
+			Backoff:  warp.ClearBackoff,
+		},
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
 		return nil, err
 	}
 
-	wb, err := NewWarp2(cc.Config, cc.Topic, cc.EnergyManager, cc.Timeout)
+	// fw1 configs are interpreted under fw2 semantics
+	if cc.UseMeter != nil {
+		util.NewLogger("warp").WARN.Println("usemeter is not supported by firmware v2 and ignored, meter is detected automatically")
+	}
+	if sub := path.Base(cc.Topic); slices.Contains([]string{"evse", "meter", "charge_tracker", "nfc"}, sub) {
+		util.NewLogger("warp").WARN.Printf("topic '%s' looks like a firmware v1 topic, expected root topic like '%s'", cc.Topic, path.Dir(cc.Topic))
+	}
+
+	if cc.CurrentResolution < 1 {
+		return nil, fmt.Errorf("invalid current resolution: %dmA", cc.CurrentResolution)
+	}
+
+	if cc.GridLimit < 0 {
+		return nil, fmt.Errorf("invalid grid limit: %.3gA", cc.GridLimit)
+	}
+
+	if cc.StaleGrace < 0 {
+		return nil, fmt.Errorf("invalid stale grace: %v", cc.StaleGrace)
+	}
+
+	if cc.PausedCurrent < 0 {
+		return nil, fmt.Errorf("invalid paused current: %.3gA", cc.PausedCurrent)
+	}
+
+	if cc.EnableCurrent != 0 && (cc.EnableCurrent*1e3 < warp.MinCurrent || cc.EnableCurrent*1e3 > warp.MaxCurrent) {
+		return nil, fmt.Errorf("invalid enable current: %.3gA", cc.EnableCurrent)
+	}
+
+	if cc.CurrentTolerance < 0 {
+		return nil, fmt.Errorf("invalid current tolerance: %.3gA", cc.CurrentTolerance)
+	}
+
+	if cc.NominalVoltage <= 0 {
+		return nil, fmt.Errorf("invalid nominal voltage: %.4gV", cc.NominalVoltage)
+	}
+
+	if cc.VoltageSag <= 0 || cc.VoltageSag >= 100 {
+		return nil, fmt.Errorf("invalid voltage sag: %.3g%%", cc.VoltageSag)
+	}
+
+	for f := range cc.Features {
+		if !slices.Contains(warp.Features, f) {
+			return nil, fmt.Errorf("invalid feature override: %s", f)
+		}
+	}
+
+	if cc.ErrorClear.Auto && (cc.ErrorClear.Attempts < 1 || cc.ErrorClear.Backoff <= 0) {
+		return nil, fmt.Errorf("invalid error clear: %d attempts, %v backoff", cc.ErrorClear.Attempts, cc.ErrorClear.Backoff)
+	}
+
+	if cc.Disable.Hold < 0 || cc.Disable.Ramp && cc.Disable.Hold == 0 {
+		return nil, fmt.Errorf("invalid disable hold: %v", cc.Disable.Hold)
+	}
+
+	if cc.Phases != 0 && cc.Phases != 1 && cc.Phases != 3 {
+		return nil, fmt.Errorf("invalid phases: %d", cc.Phases)
+	}
+
+	if cc.PowerSource != warp.PowerTotal && cc.PowerSource != warp.PowerPhases {
+		return nil, fmt.Errorf("invalid power source: %s", cc.PowerSource)
+	}
+
+	if cc.PowerSign != warp.PowerImport && cc.PowerSign != warp.PowerExport {
+		return nil, fmt.Errorf("invalid power sign: %s", cc.PowerSign)
+	}
+
+	if cc.Shutdown != warp.ShutdownHold && cc.Shutdown != warp.ShutdownRelease {
+		return nil, fmt.Errorf("invalid shutdown behaviour: %s", cc.Shutdown)
+	}
+
+	if cc.NfcConflict != warp.ConflictWarn && cc.NfcConflict != warp.ConflictYield {
+		return nil, fmt.Errorf("invalid nfc conflict behaviour: %s", cc.NfcConflict)
+	}
+
+	// low latency updates without broker for local deployments
+	var client *mqtt.Client
+	switch cc.Transport {
+	case warp.TransportMqtt:
+	case warp.TransportWs:
+		if cc.Host == "" {
+			return nil, errors.New("ws transport: missing host")
+		}
+		if cc.EnergyManager != "" || cc.Write.Broker != "" {
+			return nil, errors.New("ws transport: energy manager and write broker require mqtt transport")
+		}
+		client = warp.NewWebsocketClient(util.NewLogger("warp"), cc.Host, cc.Topic)
+	default:
+		return nil, fmt.Errorf("invalid transport: %s", cc.Transport)
+	}
+
+	if cc.Minimal {
+		wb, err := newWarp2(client, cc.Config, cc.Topic, "", cc.Host, cc.Timeout, true)
+		if err != nil {
+			return nil, err
+		}
+
+		wb.resolution = cc.CurrentResolution
+		wb.gridLimit = int64(cc.GridLimit * 1e3)
+		wb.offlineGrace = cc.OfflineGrace
+		wb.to.WithGrace(wb.log, cc.StaleGrace)
+
+		// min current is subscribed, max current defaults without slots
+		return struct {
+			api.Charger
+			api.ChargerEx
+			api.CurrentLimiter
+		}{wb, wb, wb}, nil
+	}
+
+	wb, err := newWarp2(client, cc.Config, cc.Topic, cc.EnergyManager, cc.Host, cc.Timeout, false)
 	if err != nil {
 		return nil, err
 	}
 
-	var currentPower, totalEnergy func() (float64, error)
-	if wb.hasFeature(cc.Topic, warp.FeatureMeter, cc.Timeout) {
+	// catch misconfigured topics immediately instead of timing out later
+	if cc.StartupWait > 0 {
+		if err := wb.waitForData(cc.StartupWait, "evse/state", "evse/external_current"); err != nil {
+			return nil, err
+		}
+	}
+
+	// read external meter on non-default slot
+	if cc.MeterSlot != 0 {
+		if cc.MeterSlot < 0 || cc.MeterSlot >= warp.MeterSlots {
+			return nil, fmt.Errorf("invalid meter slot: %d", cc.MeterSlot)
+		}
+
+		sub := warp.MeterTopic(cc.MeterSlot)
+		if err := wb.waitForData(cc.Timeout, sub+"/values"); err != nil {
+			return nil, fmt.Errorf("meter slot %d: %w", cc.MeterSlot, err)
+		}
+
+		if err := wb.meterGetters(sub); err != nil {
+			return nil, err
+		}
+	}
+
+	if phaseMeter {
+		if err := wb.phaseMeterGetter(warp.MeterTopic(cc.MeterSlot)); err != nil {
+			return nil, err
+		}
+	}
+
+	if cc.Qos != nil {
+		if *cc.Qos > 2 {
+			return nil, fmt.Errorf("invalid qos: %d", *cc.Qos)
+		}
+
+		wb.commandQos = *cc.Qos
+	}
+
+	if cc.Qos != nil || cc.Retained {
+		wb.retained = cc.Retained
+		if err := wb.setters(wb.writer, cc.EnergyManager); err != nil {
+			return nil, err
+		}
+	}
+
+	// publish commands to separate broker
+	if cc.Write.Broker != "" {
+		writer, err := mqtt.RegisteredClientOrDefault(wb.log, cc.Write)
+		if err != nil {
+			if errors.Is(err, mqtt.ErrAuthentication) {
+				return nil, fmt.Errorf("write broker: check broker user and password: %w", err)
+			}
+			return nil, fmt.Errorf("write broker: %w", err)
+		}
+
+		if err := wb.setters(writer, cc.EnergyManager); err != nil {
+			return nil, err
+		}
+	}
+
+	// return control to the box when evcc stops and reclaim on start
+	if cc.Shutdown == warp.ShutdownRelease {
+		if err := wb.externalEnabledS(true); err != nil {
+			return nil, err
+		}
+		shutdown.Register(wb.releaseControl)
+	}
+
+	// prove liveness by blinking the indicator led until shutdown
+	if cc.Heartbeat {
+		done := make(chan struct{})
+		go wb.heartbeat(done)
+		shutdown.Register(func() { close(done) })
+	}
+
+	// box schedules override evcc at schedule boundaries
+	if cc.Checks.Schedules {
+		go wb.checkSchedules()
+	}
+
+	// report control to external monitoring until shutdown
+	if cc.Monitor.Topic != "" {
+		if cc.Monitor.Interval <= 0 {
+			cc.Monitor.Interval = warp.MonitorInterval
+		}
+
+		done := make(chan struct{})
+		go wb.monitor(cc.Monitor, done)
+		shutdown.Register(func() { close(done) })
+	}
+
+	wb.resolution = cc.CurrentResolution
+	if cc.EnableCurrent != 0 {
+		wb.current = int64(cc.EnableCurrent * 1e3)
+	}
+	wb.gridLimit = int64(cc.GridLimit * 1e3)
+	wb.signedCurrents = cc.SignedCurrents
+	wb.offlineGrace = cc.OfflineGrace
+	wb.to.WithGrace(wb.log, cc.StaleGrace)
+	wb.invertPower = cc.PowerSign == warp.PowerExport
+	wb.pausedCurrent = cc.PausedCurrent
+	wb.hold = disableHold{disableHoldSettings: cc.Disable}
+	wb.lineVoltages = cc.LineVoltages
+	wb.display.publishSoc = cc.PublishSoc
+	wb.holdPhases = cc.HoldPhases
+	wb.singlePhase = cc.Phases == 1
+	wb.currentTolerance = cc.CurrentTolerance
+	wb.voltageSag = cc.VoltageSag
+	wb.stopCharging = cc.StopCharging
+
+	// adapt staleness detection to the box's update interval
+	if cc.AdaptiveTimeout {
+		if cc.Timeout <= 0 {
+			return nil, errors.New("adaptive timeout: requires timeout as upper bound")
+		}
+
+		cadence := new(warp.Cadence)
+		if err := wb.listen(fmt.Sprintf("%s/evse/low_level_state", wb.root), func(string) { cadence.Update(time.Now()) }); err != nil {
+			return nil, err
+		}
+		wb.cadence = cadence
+	}
+
+	// detect brokers stalling while connected
+	if cc.Keepalive > 0 {
+		if err := wb.keepalive(cc.Keepalive); err != nil {
+			return nil, err
+		}
+	}
+
+	// plug and charge events faster than the loadpoint update interval
+	if err := wb.listen(fmt.Sprintf("%s/evse/state", wb.root), wb.trackStatus); err != nil {
+		return nil, err
+	}
+
+	// recoverable evse errors require a reset
+	wb.errorClear = errorClear{errorClearSettings: cc.ErrorClear, timeout: warp.ClearTimeout, interval: warp.StopInterval}
+	if cc.ErrorClear.Auto {
+		if err := wb.listen(fmt.Sprintf("%s/evse/state", wb.root), wb.autoClear); err != nil {
+			return nil, err
+		}
+	}
+
+	// box reboots reset the setpoints unless restored from retained commands
+	if !cc.Retained {
+		if err := wb.listen(fmt.Sprintf("%s/info/keep_alive", wb.root), wb.checkUptime); err != nil {
+			return nil, err
+		}
+	}
+
+	// verify the current signalled to the vehicle
+	if cc.Checks.Offered {
+		if err := wb.listen(fmt.Sprintf("%s/evse/low_level_state", wb.root), wb.checkDutyCycle); err != nil {
+			return nil, err
+		}
+	}
+
+	// session timestamps depend on the box clock
+	if cc.Checks.Clock {
+		if err := wb.listen(fmt.Sprintf("%s/ntp/state", wb.root), wb.checkClock); err != nil {
+			return nil, err
+		}
+	}
+
+	// monitor contactor wear
+	if cc.ContactorCycles > 0 {
+		if err := wb.listen(fmt.Sprintf("%s/evse/low_level_state", wb.root), wb.checkContactorCycles(cc.ContactorCycles)); err != nil {
+			return nil, err
+		}
+	}
+
+	// work around firmware under-reporting capabilities
+	wb.featureOverrides = cc.Features
+	wb.featureCache = cc.FeatureCache
+	for _, f := range warp.Features {
+		if enabled, ok := cc.Features[f]; ok && enabled {
+			wb.log.WARN.Printf("feature %s: force enabled", f)
+		} else if ok {
+			wb.log.WARN.Printf("feature %s: force disabled", f)
+		}
+	}
+
+	// newer firmware switches phases without energy manager
+	if cc.EnergyManager != "" && cc.Features[warp.FeaturePhaseSwitch] {
+		return nil, errors.New("phase switching: configure either energy manager or evse phase switch feature, not both")
+	}
+	if cc.EnergyManager == "" && wb.hasFeature(cc.Topic, warp.FeaturePhaseSwitch, cc.Timeout) {
+		if err := wb.evsePhaseSwitching(); err != nil {
+			return nil, err
+		}
+	}
+
+	// collapse rapid increases to protect broker and energy manager relay, decreases are published immediately
+	wb.maxcurrentS = warp.Coalesce(wb.log, cc.PublishInterval, wb.maxcurrentS)
+	wb.phasesS = warp.Coalesce(wb.log, cc.PublishInterval, wb.trackPhases(wb.phasesS))
+	wb.display.name = cc.DisplayName
+	wb.nominalVoltage = cc.NominalVoltage
+	wb.yieldConflict = cc.NfcConflict == warp.ConflictYield
+
+	// hardware variant restricts capabilities regardless of advertised features unless forced. It is
+	// read once when needed, capabilities are not restricted if the box name is not received in time.
+	metered := sync.OnceValue(func() bool {
+		v := wb.hardwareVariant()
+		wb.log.INFO.Printf("hardware variant: %s", v)
+		return v.Metered()
+	})
+
+	if ceiling, source := wb.ceiling(); source != "default" {
+		wb.log.INFO.Printf("max current: %.3gA (%s)", float64(ceiling)/1e3, source)
+	} else {
+		wb.log.INFO.Printf("max current: not reported, assuming %.3gA", float64(ceiling)/1e3)
+	}
+
+	var currentPower, totalEnergy, chargedEnergy func() (float64, error)
+	if wb.hasFeature(cc.Topic, warp.FeatureMeter, cc.Timeout) && (wb.featureOverrides[warp.FeatureMeter] || metered()) {
 		currentPower = wb.currentPower
-		totalEnergy = wb.totalEnergy
+		chargedEnergy = wb.chargedEnergy
+		totalEnergy = meter.NewMonotonic(wb.log, fmt.Sprintf("warp2.%s.totalEnergy", wb.root), warp.EnergyReset, wb.totalEnergy).TotalEnergy
 	}
 
-	var currents, voltages func() (float64, float64, float64, error)
-	if wb.hasFeature(cc.Topic, warp.FeatureMeterPhases, cc.Timeout) {
+	var currents, voltages, energies func() (float64, float64, float64, error)
+	if wb.hasFeature(cc.Topic, warp.FeatureMeterPhases, cc.Timeout) && (wb.featureOverrides[warp.FeatureMeterPhases] || metered()) {
 		currents = wb.currents
 		voltages = wb.voltages
+		energies = wb.energies
+		wb.meterVoltages = true
+
+		if cc.PowerSource == warp.PowerPhases && currentPower != nil {
+			currentPower = wb.phasePower
+		}
+
+		if wb.lineVoltages {
+			if _, _, _, err := wb.voltages(); errors.Is(err, api.ErrNotAvailable) {
+				return nil, errors.New("line voltages: not provided by meter")
+			}
+		}
+	}
+
+	if cc.PowerSource == warp.PowerPhases && (currentPower == nil || currents == nil) {
+		return nil, errors.New("power source: phase values not provided by meter")
+	}
+
+	// meter may be disconnected after boot while the feature is still advertised
+	if currentPower != nil && cc.MeterMissing.Timeout > 0 {
+		wb.meterWatch = meterWatch{meterMissingSettings: cc.MeterMissing}
+		currentPower = wb.watchMeter(currentPower)
+	}
+
+	wb.bidirectional = wb.hasFeature(cc.Topic, warp.FeatureBidirectional, cc.Timeout)
+	wb.iso15118 = wb.hasFeature(cc.Topic, warp.FeatureIso15118, cc.Timeout)
+
+	// vehicle data is only obtained via high level communication
+	var soc, vehicleCurrent func() (float64, error)
+	if wb.iso15118 {
+		soc = wb.vehicleSoc
+		vehicleCurrent = wb.vehicleCurrent
+	}
+
+	// temperature is only published by some firmware, detected in background to not delay startup
+	if cc.Temperature {
+		go wb.detectTemperature(cc.Timeout)
 	}
 
-	var identity func() (string, error)
+	// box meter can be referenced by name in site config, e.g. `meters: [warp-livingroom-meter]`
+	if cc.MeterName != "" {
+		if err := wb.registerMeter(cc.MeterName, currentPower, totalEnergy, currents, voltages); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		identity   func() (string, error)
+		authorized func() (bool, error)
+	)
 	if wb.hasFeature(cc.Topic, warp.FeatureNfc, cc.Timeout) {
-		identity = wb.identify
+		identity, authorized = wb.identify, wb.authorized
+		wb.nfc = true
+
+		// track published tag lists for confirming reloads
+		if err := wb.listen(fmt.Sprintf("%s/nfc/config", wb.root), wb.updateNfcConfig); err != nil {
+			return nil, err
+		}
 	}
 
-	var phases func(int) error
-	if cc.EnergyManager != "" {
-		if res, err := wb.emState(); err == nil && res.ExternalControl != 1 {
-			phases = wb.phases1p3p
+	var (
+		phases    func(int) error
+		getPhases func() (int, error)
+	)
+	if cc.EnergyManager != "" || wb.evsePhases {
+		phases, getPhases = wb.phases1p3p, wb.getPhases
+		wb.phaseSwitching = true
+
+		stateTopic := fmt.Sprintf("%s/energy_manager/state", cc.EnergyManager)
+		if wb.evsePhases {
+			stateTopic = fmt.Sprintf("%s/evse/external_control", wb.root)
+		}
+
+		// track phase switches confirmed by the energy manager or evse
+		if err := wb.listen(stateTopic, wb.confirmPhases); err != nil {
+			return nil, err
 		}
+
+		// external control is checked in background to not delay startup, switching fails while unavailable
+		go wb.checkPhaseSwitching()
+	}
+
+	var price func(float64, string) error
+	if cc.PublishPrice {
+		price = wb.setPrice
+	}
+
+	// faults are classified by the evse error state
+	var transientFault func() (bool, error)
+	if wb.hasFeature(cc.Topic, warp.FeatureEvse, cc.Timeout) {
+		transientFault = wb.transientFault
 	}
 
-	return decorateWarp2(wb, currentPower, totalEnergy, currents, voltages, identity, phases), err
+	return decorateWarp2(wb, currentPower, totalEnergy, currents, voltages, identity, phases, chargedEnergy, soc, vehicleCurrent, getPhases, energies, authorized, price, transientFault), err
+}
+
+// NewWarpFw2FromConfig creates a new configurable charger using the deprecated warp-fw2 type
+// TODO remove warp-fw2 alias
+func NewWarpFw2FromConfig(other map[string]interface{}) (api.Charger, error) {
+	util.NewLogger("warp").WARN.Println("charger type 'warp-fw2' is deprecated and will be removed, use 'warp2' instead")
+	return NewWarp2FromConfig(other)
 }
 
 // NewWarp2 creates a new configurable charger
 func NewWarp2(mqttconf mqtt.Config, topic, emTopic string, timeout time.Duration) (*Warp2, error) {
+	return newWarp2(nil, mqttconf, topic, emTopic, "", timeout, false)
+}
+
+// newWarp2 creates the charger. Minimal chargers only subscribe the status and current topics for low broker
+// load with many boxes on constrained devices. Feature detection, meter, nfc and charge tracker are skipped,
+// as are the charge manager allocation, firmware update and clock checks, and the related getters report
+// api.ErrNotAvailable. The client is created from the mqtt config unless given.
+func newWarp2(client *mqtt.Client, mqttconf mqtt.Config, topic, emTopic, host string, timeout time.Duration, minimal bool) (*Warp2, error) {
 	log := util.NewLogger("warp")
 
-	client, err := mqtt.RegisteredClientOrDefault(log, mqttconf)
+	var err error
+
+	switch file := os.Getenv("EVCC_WARP_REPLAY"); {
+	case client != nil:
+		// transport other than broker
+	case file != "":
+		// replay recorded messages for reproducing reported issues
+		client, err = mqtt.NewReplayClient(log, file)
+	default:
+		// authentication failures are reported to the charger that triggered the connection
+		// instead of degrading into topic timeouts later
+		client, err = mqtt.RegisteredClientOrDefault(log, mqttconf)
+	}
 	if err != nil {
+		if errors.Is(err, mqtt.ErrAuthentication) {
+			return nil, fmt.Errorf("check broker user and password: %w", err)
+		}
 		return nil, err
 	}
 
+	// a dedicated broker config matching the default broker resolves to the shared client
+	shared := client == mqtt.Instance
+	if shared && mqttconf.Broker != "" {
+		log.WARN.Printf("broker %s matches default broker, using shared client %s", client.Broker(), client.ClientID())
+	} else {
+		log.INFO.Printf("broker %s, client id %s (shared: %t)", client.Broker(), client.ClientID(), shared)
+	}
+
 	wb := &Warp2{
-		log:     log,
-		client:  client,
-		current: 6000, // mA
+		log:        log,
+		client:     client,
+		root:       topic,
+		emTopic:    emTopic,
+		timeout:    timeout,
+		shared:     shared,
+		current:    6000, // mA
+		resolution: 1,    // mA
+		commandQos: warp.CommandQoS,
 	}
 
+	wb.host = host
+
+	// retained values are outdated while the broker is disconnected
+	client.OnConnectionChange(wb.connectionChanged)
+
 	// timeout handler
-	h, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/evse/low_level_state", topic), timeout).StringGetter()
+	lowLevel := fmt.Sprintf("%s/evse/low_level_state", topic)
+	h, err := wb.pollFallback(lowLevel, provider.NewMqtt(log, client, lowLevel, timeout).WithStartupDedup(warp.StartupDedup)).StringGetter()
 	if err != nil {
 		return nil, err
 	}
-	to := provider.NewTimeoutHandler(h)
-
-	mq := func(s string, args ...any) *provider.Mqtt {
-		return provider.NewMqtt(log, client, fmt.Sprintf(s, args...), 0)
-	}
+	wb.lowLevelG = h
+	wb.to = provider.NewTimeoutHandler(wb.ticker)
+	wb.topics = warp.NewTopics(log, client, wb.to, wb.pollFallback)
 
-	wb.maxcurrentG, err = to.StringGetter(mq("%s/evse/external_current", topic))
+	wb.maxcurrentG, err = wb.topics.Getter("%s/evse/external_current", topic)
 	if err != nil {
 		return nil, err
 	}
-	wb.statusG, err = to.StringGetter(mq("%s/evse/state", topic))
+	wb.mincurrentG, err = wb.topics.Getter("%s/evse/min_charging_current", topic)
 	if err != nil {
 		return nil, err
 	}
-	wb.meterG, err = to.StringGetter(mq("%s/meter/values", topic))
+	wb.statusG, err = wb.topics.Getter("%s/evse/state", topic)
 	if err != nil {
 		return nil, err
 	}
-	wb.meterDetailsG, err = to.StringGetter(mq("%s/meter/all_values", topic))
+
+	if minimal {
+		wb.skipGetters()
+		return wb, wb.setters(client, emTopic)
+	}
+
+	wb.slotsG, err = wb.topics.Getter("%s/evse/slots", topic)
 	if err != nil {
 		return nil, err
 	}
-	wb.chargeG, err = to.StringGetter(mq("%s/charge_tracker/current_charge", topic))
+	// named profiles are only published by boxes managed by a building energy system
+	wb.profileG, err = wb.topics.Optional("%s/evse/profile", topic)
 	if err != nil {
 		return nil, err
 	}
-	wb.userconfigG, err = to.StringGetter(mq("%s/users/config", topic))
+
+	wb.autoStartG, err = wb.topics.Optional("%s/evse/auto_start_charging", topic)
 	if err != nil {
 		return nil, err
 	}
 
-	wb.maxcurrentS, err = provider.NewMqtt(log, client,
-		fmt.Sprintf("%s/evse/external_current_update", topic), 0).
-		WithPayload(`{ "current": ${maxcurrent} }`).
-		IntSetter("maxcurrent")
+	// only published by firmware supporting prioritized load management
+	wb.priorityG, err = wb.topics.Optional("%s/evse/management_priority", topic)
 	if err != nil {
 		return nil, err
 	}
-
-	wb.emStateG, err = to.StringGetter(mq("%s/energy_manager/state", emTopic))
+	if err := wb.meterGetters(warp.MeterTopic(0)); err != nil {
+		return nil, err
+	}
+	wb.chargeG, err = wb.topics.Getter("%s/charge_tracker/current_charge", topic)
 	if err != nil {
 		return nil, err
 	}
-	wb.phasesS, err = provider.NewMqtt(log, client,
-		fmt.Sprintf("%s/energy_manager/external_control_update", emTopic), 0).
-		WithPayload(`{ "phases_wanted": ${phases} }`).
-		IntSetter("phases")
+	wb.userconfigG, err = wb.topics.Getter("%s/users/config", topic)
 	if err != nil {
 		return nil, err
 	}
 
-	return wb, nil
-}
+	// vehicle data is only published while a car communicates via ISO 15118
+	wb.iso15118G, err = wb.topics.Optional("%s/iso15118/state", topic)
+	if err != nil {
+		return nil, err
+	}
 
-func (wb *Warp2) hasFeature(root, feature string, timeout time.Duration) bool {
-	if wb.features != nil {
-		return slices.Contains(wb.features, feature)
+	// firmware updates interrupt control
+	if err := wb.listen(fmt.Sprintf("%s/firmware_update/state", topic), wb.firmwareUpdate); err != nil {
+		return nil, err
 	}
 
-	topic := fmt.Sprintf("%s/info/features", root)
+	// track confirmed setpoint
+	if err := wb.listen(fmt.Sprintf("%s/evse/external_current", topic), wb.confirmSetpoint); err != nil {
+		return nil, err
+	}
 
-	if dataG, err := provider.NewMqtt(wb.log, wb.client, topic, timeout).StringGetter(); err == nil {
-		if data, err := dataG(); err == nil {
-			if err := json.Unmarshal([]byte(data), &wb.features); err == nil {
-				return slices.Contains(wb.features, feature)
-			}
+	// only subscribed if an energy manager is configured
+	wb.emStateG = func() (string, error) {
+		return "", api.ErrNotAvailable
+	}
+	if emTopic != "" {
+		wb.emStateG, err = wb.topics.Getter("%s/energy_manager/state", emTopic)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return false
-}
-
-// Enable implements the api.Charger interface
-func (wb *Warp2) Enable(enable bool) error {
-	var current int64
-	if enable {
-		current = wb.current
+	if err := wb.setters(client, emTopic); err != nil {
+		return nil, err
 	}
-	return wb.maxcurrentS(current)
+
+	return wb, nil
 }
 
-// Enabled implements the api.Charger interface
-func (wb *Warp2) Enabled() (bool, error) {
-	var res warp.EvseExternalCurrent
+// connectionChanged tracks the broker connection
+func (wb *Warp2) connectionChanged(connected bool) {
+	wb.disconnected.Store(!connected)
+}
 
-	s, err := wb.maxcurrentG()
-	if err == nil {
-		err = json.Unmarshal([]byte(s), &res)
+// listen attaches an optional listener. Subscriptions refused by the broker disable the listener instead of failing.
+func (wb *Warp2) listen(topic string, callback func(string)) error {
+	err := wb.client.Listen(topic, callback)
+	if errors.Is(err, mqtt.ErrRejected) {
+		wb.log.WARN.Printf("%v, ignored", err)
+		return nil
 	}
+	return err
+}
 
-	return res.Current >= 6000, err
+// fallback subscribes a topic and polls the box's http api instead if the broker refuses the subscription
+type fallback struct {
+	wb    *Warp2
+	topic string
+	p     provider.StringProvider
 }
 
-// Status implements the api.Charger interface
-func (wb *Warp2) Status() (api.ChargeStatus, error) {
-	res := api.StatusNone
+// pollFallback creates the provider of topic polling the http api if the subscription is rejected.
+// Topics outside the box's root topic, e.g. energy manager state, are not polled.
+func (wb *Warp2) pollFallback(topic string, p provider.StringProvider) provider.StringProvider {
+	return &fallback{wb: wb, topic: topic, p: p}
+}
 
-	s, err := wb.statusG()
-	if err != nil {
-		return res, err
+func (f *fallback) StringGetter() (func() (string, error), error) {
+	g, err := f.p.StringGetter()
+	if !errors.Is(err, mqtt.ErrRejected) {
+		return g, err
 	}
 
-	var status warp.EvseState
-	if err := json.Unmarshal([]byte(s), &status); err != nil {
-		return res, err
+	sub, ok := strings.CutPrefix(f.topic, f.wb.root+"/")
+	if !ok {
+		return nil, err
 	}
 
-	switch status.Iec61851State {
-	case 0:
-		res = api.StatusA
-	case 1:
-		res = api.StatusB
-	case 2:
-		res = api.StatusC
-	default:
-		err = fmt.Errorf("invalid status: %d", status.Iec61851State)
+	if f.wb.host == "" {
+		return nil, fmt.Errorf("%w: configure host for polling the http api instead", err)
 	}
 
-	return res, err
+	poll := warp.NewPoll(f.wb.log, f.wb.host, sub)
+	f.wb.log.WARN.Printf("%v, polling %s", err, poll.URI())
+
+	return poll.StringGetter()
 }
 
-// MaxCurrent implements the api.Charger interface
-func (wb *Warp2) MaxCurrent(current int64) error {
-	return wb.MaxCurrentMillis(float64(current))
+// ticker is the timeout handler's staleness check. With adaptive timeout, data is stale if the low level state
+// was not updated within a multiple of its observed interval.
+func (wb *Warp2) ticker() (string, error) {
+	s, err := wb.lowLevelG()
+	if err == nil && wb.cadence != nil && wb.cadence.Stale(time.Now(), wb.timeout) {
+		err = api.ErrOutdated
+	}
+	return s, err
 }
 
-var _ api.ChargerEx = (*Warp2)(nil)
+// skipGetters makes all getters not subscribed in minimal mode report api.ErrNotAvailable
+func (wb *Warp2) skipGetters() {
+	skipped := func() (string, error) {
+		return "", api.ErrNotAvailable
+	}
 
-// MaxCurrentMillis implements the api.ChargerEx interface
-func (wb *Warp2) MaxCurrentMillis(current float64) error {
-	curr := int64(current * 1e3)
-	err := wb.maxcurrentS(curr)
-	if err == nil {
-		wb.current = curr
+	for _, g := range []*func() (string, error){
+		&wb.slotsG, &wb.profileG, &wb.priorityG, &wb.autoStartG, &wb.meterG, &wb.meterDetailsG, &wb.valueIdsG,
+		&wb.chargeG, &wb.userconfigG, &wb.emStateG, &wb.iso15118G,
+	} {
+		*g = skipped
 	}
-	return err
 }
 
-// CurrentPower implements the api.Meter interface
-func (wb *Warp2) currentPower() (float64, error) {
-	var res warp.MeterValues
+// meterGetters creates the meter getters for the meter subtree below the root topic
+func (wb *Warp2) meterGetters(sub string) error {
+	meterG, err := wb.topics.Frequent("%s/%s/values", wb.root, sub)
+	if err != nil {
+		return err
+	}
+	wb.meterG = wb.finite(meterG)
+	meterDetailsG, err := wb.topics.Frequent("%s/%s/all_values", wb.root, sub)
+	if err != nil {
+		return err
+	}
+	// currents, voltages and diagnostics read the same values within one update
+	wb.meterDetailsG = provider.Cached(wb.finite(meterDetailsG), warp.MeterCache)
+	wb.valueIdsG, err = wb.topics.Getter("%s/%s/value_ids", wb.root, sub)
 
-	s, err := wb.meterG()
+	return err
+}
+
+// phaseMeterGetter subscribes the warp3 phases topic of the meter subtree
+func (wb *Warp2) phaseMeterGetter(sub string) error {
+	g, err := wb.topics.Getter("%s/%s/phases", wb.root, sub)
 	if err == nil {
-		err = json.Unmarshal([]byte(s), &res)
+		wb.phasesG = wb.finite(g)
 	}
-
-	return res.Power, err
+	return err
 }
 
-// TotalEnergy implements the api.MeterEnergy interface
-func (wb *Warp2) totalEnergy() (float64, error) {
-	var res warp.MeterValues
+// evsePhaseSwitching uses the evse external control for phase switching instead of the energy manager.
+// The external control state shares the energy manager state's fields.
+func (wb *Warp2) evsePhaseSwitching() error {
+	var err error
+	if wb.emStateG, err = wb.topics.Getter("%s/evse/external_control", wb.root); err != nil {
+		return err
+	}
 
-	s, err := wb.meterG()
+	wb.phasesS, err = warp.NewTopics(wb.log, wb.writer, nil, nil).WithQoS(wb.commandQos).WithRetained(wb.retained).IntSetter(fmt.Sprintf("%s/evse/external_control_update", wb.root), "phases", `{ "phases_wanted": ${phases} }`)
 	if err == nil {
-		err = json.Unmarshal([]byte(s), &res)
+		wb.evsePhases = true
 	}
 
-	return res.EnergyAbs, err
+	return err
 }
 
-func (wb *Warp2) meterValues() ([]float64, error) {
-	s, err := wb.meterDetailsG()
-	if err != nil {
-		return nil, err
+// finite wraps a meter getter to replace payloads with null or non-finite readings by the last good payload
+// instead of propagating zero values
+func (wb *Warp2) finite(g func() (string, error)) func() (string, error) {
+	var mu sync.Mutex
+	var last string
+
+	return func() (string, error) {
+		s, err := g()
+		if err != nil {
+			return s, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err := warp.CheckFinite(s); err != nil {
+			if last == "" {
+				return "", fmt.Errorf("meter: %w: %s", err, s)
+			}
+
+			wb.log.WARN.Printf("meter: %v: %s, using last value", err, s)
+			return last, nil
+		}
+
+		last = s
+		return s, nil
 	}
+}
 
-	var res []float64
-	if err := json.Unmarshal([]byte(s), &res); err != nil {
-		return nil, err
+// setters creates the command setters publishing to the client
+func (wb *Warp2) setters(client *mqtt.Client, emTopic string) error {
+	wb.writer = client
+	cmd := warp.NewTopics(wb.log, client, nil, nil).WithQoS(wb.commandQos)
+
+	// commanded state is restored from retained messages after a broker restart
+	state := warp.NewTopics(wb.log, client, nil, nil).WithQoS(wb.commandQos).WithRetained(wb.retained)
+
+	maxcurrentS, err := state.IntSetter(fmt.Sprintf("%s/evse/external_current_update", wb.root), "maxcurrent", `{ "current": ${maxcurrent} }`)
+	if err != nil {
+		return err
 	}
+	wb.maxcurrentS = wb.trackCurrent(maxcurrentS)
 
-	if len(res) <= 5 {
-		return nil, errors.New("invalid length")
+	wb.externalEnabledS, err = cmd.BoolSetter(fmt.Sprintf("%s/evse/external_enabled_update", wb.root), "enabled", `{ "enabled": ${enabled} }`)
+	if err != nil {
+		return err
 	}
 
-	return res, nil
+	wb.phasesS, err = state.IntSetter(fmt.Sprintf("%s/energy_manager/external_control_update", emTopic), "phases", `{ "phases_wanted": ${phases} }`)
+	if err != nil {
+		return err
+	}
+
+	wb.display.socS, err = cmd.IntSetter(fmt.Sprintf("%s/front_panel/soc_update", wb.root), "soc", `{ "soc": ${soc} }`)
+	if err != nil {
+		return err
+	}
+
+	priceTopic := fmt.Sprintf("%s/front_panel/price_update", wb.root)
+	wb.display.priceS = func(price warp.Price) error {
+		b, err := json.Marshal(price)
+		if err == nil {
+			err = client.PublishQoS(priceTopic, wb.commandQos, false, string(b))
+		}
+		return err
+	}
+
+	resetTopic := fmt.Sprintf("%s/evse/reset", wb.root)
+	wb.resetS = func() error {
+		return client.PublishQoS(resetTopic, wb.commandQos, false, "null")
+	}
+
+	stopTopic := fmt.Sprintf("%s/evse/stop_charging", wb.root)
+	wb.stopS = func() error {
+		return client.PublishQoS(stopTopic, wb.commandQos, false, "null")
+	}
+
+	startTopic := fmt.Sprintf("%s/evse/start_charging", wb.root)
+	wb.startS = func() error {
+		return client.PublishQoS(startTopic, wb.commandQos, false, "null")
+	}
+
+	wb.signedCurrentS, err = cmd.IntSetter(fmt.Sprintf("%s/evse/bidirectional_current_update", wb.root), "current", `{ "current": ${current} }`)
+
+	return err
 }
 
-// currents implements the api.MeterCurrrents interface
-func (wb *Warp2) currents() (float64, float64, float64, error) {
-	res, err := wb.meterValues()
+// waitForData waits for first data on the given topics below the root topic
+func (wb *Warp2) waitForData(timeout time.Duration, topics ...string) error {
+	deadline := time.Now().Add(timeout)
+
+	for _, sub := range topics {
+		topic := fmt.Sprintf("%s/%s", wb.root, sub)
+
+		g, err := provider.NewMqtt(wb.log, wb.client, topic, max(time.Until(deadline), time.Millisecond)).StringGetter()
+		if err == nil {
+			_, err = g()
+		}
+		if err != nil {
+			return fmt.Errorf("no data received on %s within %v, check topic: %w", topic, timeout, err)
+		}
+	}
+
+	return nil
+}
+
+// registerMeter registers the box meter using the charger's getters in the device registry
+func (wb *Warp2) registerMeter(name string, currentPower, totalEnergy func() (float64, error), currents, voltages func() (float64, float64, float64, error)) error {
+	if currentPower == nil {
+		return errors.New("meter name: box has no meter")
+	}
+
+	m, err := meter.NewConfigurable(currentPower)
 	if err != nil {
-		return 0, 0, 0, err
+		return err
+	}
+
+	dev := config.NewStaticDevice(config.Named{Name: name, Type: "warp2"}, m.Decorate(totalEnergy, currents, voltages, nil, nil, nil, nil))
+	if err := config.Meters().Add(dev); err != nil {
+		return fmt.Errorf("meter name: %w", err)
 	}
 
-	return res[3], res[4], res[5], nil
+	return nil
 }
 
-// voltages implements the api.MeterVoltages interface
-func (wb *Warp2) voltages() (float64, float64, float64, error) {
-	res, err := wb.meterValues()
+// hardwareVariant reads the hardware variant from the box info or returns warp.VariantUnknown if not received
+func (wb *Warp2) hardwareVariant() warp.Variant {
+	var res warp.InfoName
+
+	g, err := wb.configGetter("info/name")
+	if err == nil {
+		var s string
+		if s, err = g(); err == nil {
+			err = wb.unmarshal(s, &res)
+		}
+	}
 	if err != nil {
-		return 0, 0, 0, err
+		wb.log.DEBUG.Printf("hardware variant: %v", err)
+		return warp.VariantUnknown
 	}
 
-	return res[0], res[1], res[2], nil
+	return res.Variant()
 }
 
-func (wb *Warp2) identify() (string, error) {
-	var res warp.ChargeTrackerCurrentCharge
+// firmwareVersion reads the firmware version from the box info. The version is cached after the first successful read.
+func (wb *Warp2) firmwareVersion() (warp.Version, error) {
+	if wb.firmware != nil {
+		return *wb.firmware, nil
+	}
 
-	s, err := wb.chargeG()
+	var res warp.Version
+
+	g, err := wb.configGetter("info/version")
 	if err == nil {
-		err = json.Unmarshal([]byte(s), &res)
+		var s string
+		if s, err = g(); err == nil {
+			err = wb.unmarshal(s, &res)
+		}
 	}
+	if err != nil {
+		return res, err
+	}
+
+	wb.firmware = &res
 
-	return res.AuthorizationInfo.TagId, err
+	return res, nil
 }
 
-func (wb *Warp2) emState() (warp.EmState, error) {
-	var res warp.EmState
+// configGetter returns the getter of a rarely published topic below the root topic, e.g. configuration. The topic
+// is subscribed on first use and later calls reuse the subscription. The first payload is waited for up to the
+// timeout, payloads are not checked for stale data.
+func (wb *Warp2) configGetter(sub string) (func() (string, error), error) {
+	topic := fmt.Sprintf("%s/%s", wb.root, sub)
 
-	s, err := wb.emStateG()
+	wb.configMu.Lock()
+	defer wb.configMu.Unlock()
+
+	m, ok := wb.configs[topic]
+	if !ok {
+		m = util.NewMonitor[string](0)
+		if err := wb.client.Listen(topic, m.Set); err != nil {
+			return nil, err
+		}
+
+		if wb.configs == nil {
+			wb.configs = make(map[string]*util.Monitor[string])
+		}
+		wb.configs[topic] = m
+	}
+
+	return func() (string, error) {
+		select {
+		case <-m.Done():
+		case <-time.After(wb.timeout):
+			return "", fmt.Errorf("%s: %w", topic, api.ErrTimeout)
+		}
+		return m.Get()
+	}, nil
+}
+
+// hasFeature checks the features reported by the box with overrides applied. With feature cache,
+// features of the previous run are used instead of waiting for the box.
+func (wb *Warp2) hasFeature(root, feature string, timeout time.Duration) bool {
+	if wb.features != nil {
+		return slices.Contains(wb.features, feature)
+	}
+
+	key := wb.featureKey(root)
+
+	if wb.featureCache {
+		var res []string
+		if err := settings.Json(key, &res); err == nil {
+			wb.features = warp.OverrideFeatures(res, wb.featureOverrides)
+			wb.featureRefresh.Do(func() {
+				go wb.refreshFeatures(key, root, res, timeout)
+			})
+			return slices.Contains(wb.features, feature)
+		}
+	}
+
+	res, err := wb.readFeatures(root, timeout)
+	if err == nil && wb.featureCache {
+		_ = settings.SetJson(key, res)
+	}
+
+	// features are read once, unavailable features don't delay further checks
+	if err != nil {
+		wb.log.WARN.Printf("features: %v", err)
+		if wb.featureCache {
+			wb.featureRefresh.Do(func() {
+				go wb.refreshFeatures(key, root, nil, timeout)
+			})
+		}
+	}
+
+	wb.features = warp.OverrideFeatures(res, wb.featureOverrides)
+
+	return slices.Contains(wb.features, feature)
+}
+
+// featureKey is the settings key of the cached features per broker and box
+func (wb *Warp2) featureKey(root string) string {
+	return fmt.Sprintf("warp2.%s.%s.features", wb.client.Broker(), root)
+}
+
+// readFeatures waits for the features reported by the box
+func (wb *Warp2) readFeatures(root string, timeout time.Duration) ([]string, error) {
+	var res []string
+
+	g, err := provider.NewMqtt(wb.log, wb.client, fmt.Sprintf("%s/info/features", root), timeout).StringGetter()
 	if err == nil {
-		err = json.Unmarshal([]byte(s), &res)
+		var s string
+		if s, err = g(); err == nil {
+			err = wb.unmarshal(s, &res)
+		}
 	}
 
 	return res, err
 }
 
-func (wb *Warp2) phases1p3p(phases int) error {
-	res, err := wb.emState()
+// refreshFeatures updates the cached features. Capabilities are decorated at startup, changed features
+// require a restart.
+func (wb *Warp2) refreshFeatures(key, root string, cached []string, timeout time.Duration) {
+	res, err := wb.readFeatures(root, timeout)
 	if err != nil {
+		wb.log.DEBUG.Printf("feature refresh: %v", err)
+		return
+	}
+
+	if !warp.SameFeatures(cached, res) {
+		wb.log.WARN.Printf("features changed from %v to %v, restart to apply", cached, res)
+	}
+
+	_ = settings.SetJson(key, res)
+}
+
+// Enable implements the api.Charger interface. Enabling applies the last current set by MaxCurrentMillis
+// or the configured enable current if no current was set since startup. Disabling is delayed by the
+// configured hold.
+func (wb *Warp2) Enable(enable bool) error {
+	// pending disable is superseded
+	wb.cancelHold()
+
+	if !enable {
+		if wb.hold.Hold > 0 && wb.charging() {
+			return wb.startHold()
+		}
+		return wb.disable()
+	}
+
+	if err := wb.setCurrent(wb.current, true); err != nil {
 		return err
 	}
 
-	if res.ExternalControl > 0 {
-		return fmt.Errorf("external control not available: %s", res.ExternalControl.String())
+	return wb.release()
+}
+
+// charging returns true if a current is published
+func (wb *Warp2) charging() bool {
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+	return wb.published.Current > 0
+}
+
+// disable publishes zero current and optionally stops the session
+func (wb *Warp2) disable() error {
+	if err := wb.setCurrent(0, true); err != nil {
+		return err
 	}
 
-	return wb.phasesS(int64(phases))
+	if !wb.stopCharging {
+		return nil
+	}
+
+	// some vehicles only release the cable lock after the session has been stopped
+	if err := wb.stopS(); err != nil {
+		return err
+	}
+
+	go wb.confirmStop(warp.StopTimeout, warp.StopInterval)
+
+	return nil
+}
+
+type disableHoldSettings struct {
+	Hold time.Duration // keep the current before disabling
+	Ramp bool          // reduce to minimum current during hold
+}
+
+// disableHold delays disabling for vehicles terminating the session when the current drops to zero.
+// It has a separate lock as disabling publishes using the charger lock.
+type disableHold struct {
+	disableHoldSettings
+	mu    sync.Mutex
+	timer *time.Timer // pending disable
+}
+
+// startHold optionally ramps down to minimum current and disables after the hold
+func (wb *Warp2) startHold() error {
+	h := &wb.hold
+
+	if h.Ramp {
+		minCurrent := wb.minCurrent()
+
+		wb.mu.Lock()
+		current := min(wb.published.Current, minCurrent)
+		wb.mu.Unlock()
+
+		if err := wb.setCurrent(current, true); err != nil {
+			return err
+		}
+	}
+
+	wb.log.DEBUG.Printf("disable: holding current for %v", h.Hold)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var t *time.Timer
+	t = time.AfterFunc(h.Hold, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		// cancelled
+		if h.timer != t {
+			return
+		}
+		h.timer = nil
+
+		if err := wb.disable(); err != nil {
+			wb.log.ERROR.Printf("disable: %v", err)
+		}
+	})
+	h.timer = t
+
+	return nil
+}
+
+// cancelHold stops a pending disable
+func (wb *Warp2) cancelHold() {
+	h := &wb.hold
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.timer != nil {
+		h.timer.Stop()
+		h.timer = nil
+	}
+}
+
+// holding returns true while a disable is pending
+func (wb *Warp2) holding() bool {
+	h := &wb.hold
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.timer != nil
+}
+
+// AutoStart returns if the box starts charging when a vehicle is connected or api.ErrNotAvailable if not reported
+func (wb *Warp2) AutoStart() (bool, error) {
+	var res warp.EvseAutoStart
+
+	s, err := wb.autoStartG()
+	if err != nil {
+		return false, api.ErrNotAvailable
+	}
+
+	if err := wb.unmarshal(s, &res); err != nil {
+		return false, err
+	}
+
+	return res.AutoStartCharging, nil
+}
+
+// release starts the session if the box waits for charge release after the vehicle was connected.
+// Sessions waiting for nfc authorization can't be started by evcc.
+func (wb *Warp2) release() error {
+	if autoStart, err := wb.AutoStart(); err != nil || autoStart {
+		return nil
+	}
+
+	slots, err := wb.Slots()
+	if err != nil {
+		return nil
+	}
+
+	for _, s := range slots {
+		if s.Current > 0 {
+			continue
+		}
+
+		switch s.Index {
+		case warp.SlotButton:
+			wb.log.DEBUG.Println("starting session awaiting charge release")
+			return wb.startS()
+		case warp.SlotUser:
+			wb.log.WARN.Println("charging requires nfc authorization at the box")
+		}
+	}
+
+	return nil
+}
+
+// confirmStop waits for the charge tracker to report the session ended after sending the stop command
+func (wb *Warp2) confirmStop(timeout, interval time.Duration) bool {
+	for deadline := time.Now().Add(timeout); ; time.Sleep(interval) {
+		var res warp.ChargeTrackerCurrentCharge
+
+		s, err := wb.chargeG()
+		if err == nil {
+			err = wb.unmarshal(s, &res)
+		}
+
+		if err == nil && res.UserID == warp.UserNone {
+			wb.log.DEBUG.Println("stop charging: session ended")
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			wb.log.WARN.Printf("stop charging: session not ended after %v", timeout)
+			return false
+		}
+	}
+}
+
+// setCurrent publishes the external current. Unless forced, publishing is skipped if the current is unchanged
+// since the last successful publish. The current is re-asserted after warp.ReassertInterval.
+func (wb *Warp2) setCurrent(current int64, force bool) error {
+	wb.mu.Lock()
+	skip := !force && wb.published.Current == current && time.Since(wb.published.Updated) < warp.ReassertInterval
+	wb.mu.Unlock()
+
+	if skip {
+		return nil
+	}
+
+	return wb.maxcurrentS(current)
+}
+
+// trackCurrent records the current published by set as requested setpoint
+func (wb *Warp2) trackCurrent(set func(int64) error) func(int64) error {
+	return func(current int64) error {
+		err := set(current)
+
+		wb.mu.Lock()
+		defer wb.mu.Unlock()
+
+		if err != nil {
+			// publish again on next call
+			wb.published = publishedCurrent{}
+			return err
+		}
+
+		wb.published = publishedCurrent{Current: current, Updated: time.Now()}
+		wb.requested = api.Setpoint{Current: float64(current) / 1e3, Updated: wb.published.Updated}
+
+		return nil
+	}
+}
+
+// disabled returns true if evcc disabled the box by publishing zero current
+func (wb *Warp2) disabled() bool {
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+	return !wb.published.Updated.IsZero() && wb.published.Current == 0
+}
+
+// publishedCurrent is the last successfully published current
+type publishedCurrent struct {
+	Current int64 // mA
+	Updated time.Time
+}
+
+// phaseCommand is the last phase switch confirmed by the energy manager.
+// It has a separate lock since phases are switched while holding the charger lock.
+type phaseCommand struct {
+	mu        sync.Mutex
+	requested int // pending confirmation
+	confirmed int
+	updated   time.Time
+}
+
+// trackPhases records phase switches published by set for confirmation
+func (wb *Warp2) trackPhases(set func(int64) error) func(int64) error {
+	return func(phases int64) error {
+		if err := set(phases); err != nil {
+			return err
+		}
+
+		wb.phaseCommand.mu.Lock()
+		wb.phaseCommand.requested = int(phases)
+		wb.phaseCommand.mu.Unlock()
+
+		return nil
+	}
+}
+
+// confirmPhases tracks the phases switched as confirmed by the energy manager
+func (wb *Warp2) confirmPhases(payload string) {
+	var res warp.EmState
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return
+	}
+
+	wb.phaseCommand.mu.Lock()
+	defer wb.phaseCommand.mu.Unlock()
+
+	if c := &wb.phaseCommand; c.requested != 0 && res.PhasesSwitched == c.requested {
+		c.confirmed, c.updated, c.requested = c.requested, time.Now(), 0
+		wb.log.DEBUG.Printf("phase switch to %dp confirmed", c.confirmed)
+	}
+}
+
+// confirmSetpoint tracks the external current as confirmed by the box
+func (wb *Warp2) confirmSetpoint(payload string) {
+	var res warp.EvseExternalCurrent
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	if current := float64(res.Current) / 1e3; current != wb.confirmed.Current || wb.confirmed.Updated.Before(wb.requested.Updated) {
+		wb.confirmed = api.Setpoint{Current: current, Updated: time.Now()}
+	}
+}
+
+var _ api.SetpointStatus = (*Warp2)(nil)
+
+// firmwareUpdate tracks firmware updates in progress
+func (wb *Warp2) firmwareUpdate(payload string) {
+	var res warp.FirmwareUpdateState
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return
+	}
+
+	if wb.updating.Swap(res.InProgress) != res.InProgress {
+		if res.InProgress {
+			wb.log.WARN.Println("firmware update started")
+		} else {
+			wb.log.INFO.Println("firmware update completed")
+		}
+	}
+}
+
+// checkUptime detects box reboots by the uptime decreasing and re-asserts the setpoints immediately
+// instead of after warp.ReassertInterval
+func (wb *Warp2) checkUptime(payload string) {
+	var res warp.KeepAlive
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return
+	}
+
+	wb.mu.Lock()
+	rebooted := res.Uptime < wb.uptime
+	wb.uptime = res.Uptime
+	current, published := wb.published.Current, !wb.published.Updated.IsZero()
+	wb.mu.Unlock()
+
+	if !rebooted {
+		return
+	}
+
+	wb.log.WARN.Printf("box rebooted (uptime %v), re-asserting setpoints", time.Duration(res.Uptime)*time.Millisecond)
+
+	if published {
+		if err := wb.setCurrent(current, true); err != nil {
+			wb.log.ERROR.Printf("re-assert current: %v", err)
+		}
+	}
+
+	if !wb.phaseSwitching {
+		return
+	}
+
+	wb.phaseCommand.mu.Lock()
+	phases := wb.phaseCommand.requested
+	if phases == 0 {
+		phases = wb.phaseCommand.confirmed
+	}
+	wb.phaseCommand.mu.Unlock()
+
+	if phases != 0 {
+		if err := wb.phasesS(int64(phases)); err != nil {
+			wb.log.ERROR.Printf("re-assert phases: %v", err)
+		}
+	}
+}
+
+// Setpoints implements the api.SetpointStatus interface
+func (wb *Warp2) Setpoints() (api.Setpoint, api.Setpoint, error) {
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+	return wb.requested, wb.confirmed, nil
+}
+
+// Enabled implements the api.Charger interface. The box is reported disabled while holding the current before disabling.
+func (wb *Warp2) Enabled() (bool, error) {
+	if wb.holding() {
+		return false, nil
+	}
+
+	var res warp.EvseExternalCurrent
+
+	s, err := wb.maxcurrentG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	// boxes configured for lower minimum current charge below 6A
+	return int64(res.Current) >= wb.minCurrent(), err
+}
+
+// unmarshal decodes a payload, trying alternate names for fields renamed by newer firmware
+func (wb *Warp2) unmarshal(s string, res any) error {
+	used, err := warp.Unmarshal([]byte(s), res)
+
+	for _, name := range used {
+		if _, loaded := wb.variants.LoadOrStore(name, true); !loaded {
+			wb.log.DEBUG.Printf("using alternate field name: %s", name)
+		}
+	}
+
+	return err
+}
+
+// Status implements the api.Charger interface
+func (wb *Warp2) Status() (api.ChargeStatus, error) {
+	// box does not respond to control while updating
+	if wb.updating.Load() {
+		return api.StatusNone, api.ErrUpdating
+	}
+
+	res, err := wb.status()
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	if err == nil {
+		wb.lastStatus, wb.lastStatusUpdated = res, time.Now()
+		return res, nil
+	}
+
+	// hold last known status during short broker outages
+	if errors.Is(err, api.ErrTimeout) || errors.Is(err, api.ErrOutdated) {
+		if !wb.lastStatusUpdated.IsZero() && time.Since(wb.lastStatusUpdated) < wb.offlineGrace {
+			wb.log.DEBUG.Printf("status: %v, using last status", err)
+			return wb.lastStatus, nil
+		}
+	}
+
+	return res, err
+}
+
+func (wb *Warp2) status() (api.ChargeStatus, error) {
+	res := api.StatusNone
+
+	if wb.disconnected.Load() {
+		return res, fmt.Errorf("broker %s disconnected: %w", wb.client.Broker(), api.ErrTimeout)
+	}
+
+	// retained values are stale if the broker stopped forwarding messages
+	if wb.stalled.Load() {
+		return res, fmt.Errorf("broker stalled: %w", api.ErrTimeout)
+	}
+
+	s, err := wb.statusG()
+	if err != nil {
+		return res, err
+	}
+
+	var status warp.EvseState
+	if err := wb.unmarshal(s, &status); err != nil {
+		return res, err
+	}
+
+	res, err = evseStatus(status)
+
+	// stop requesting current after residual current monitor tripped
+	if fault, ok := wb.dcFaultCurrentState(); ok && fault != warp.DcFaultCurrentOk {
+		res, err = api.StatusF, nil
+	}
+
+	if err == nil && res == api.StatusC && wb.paused() {
+		res = api.StatusB
+	}
+
+	if err == nil {
+		wb.sessionConflict(res)
+	}
+
+	return res, err
+}
+
+// evseStatus converts the IEC 61851 state
+func evseStatus(state warp.EvseState) (api.ChargeStatus, error) {
+	switch state.Iec61851State {
+	case 0:
+		return api.StatusA, nil
+	case 1:
+		return api.StatusB, nil
+	case 2:
+		return api.StatusC, nil
+	case 4:
+		return api.StatusF, nil
+	default:
+		return api.StatusNone, fmt.Errorf("invalid status: %d", state.Iec61851State)
+	}
+}
+
+// transitions are the evse state changes received since the last read
+type transitions struct {
+	mu    sync.Mutex
+	last  api.ChargeStatus
+	queue []api.ChargeStatus
+}
+
+// trackStatus records evse state changes. Repeated states, e.g. retained and live message, are ignored.
+func (wb *Warp2) trackStatus(payload string) {
+	var res warp.EvseState
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return
+	}
+
+	status, err := evseStatus(res)
+	if err != nil {
+		return
+	}
+
+	t := &wb.transitions
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if status == t.last {
+		return
+	}
+
+	t.last = status
+	t.queue = append(t.queue, status)
+
+	// keep the most recent transitions if not read
+	if len(t.queue) > warp.TransitionBuffer {
+		t.queue = t.queue[len(t.queue)-warp.TransitionBuffer:]
+	}
+}
+
+var _ api.StatusTransitions = (*Warp2)(nil)
+
+// Transitions implements the api.StatusTransitions interface
+func (wb *Warp2) Transitions() []api.ChargeStatus {
+	wb.transitions.mu.Lock()
+	defer wb.transitions.mu.Unlock()
+
+	res := wb.transitions.queue
+	wb.transitions.queue = nil
+
+	return res
+}
+
+// paused detects firmware remaining in state C after evcc requested zero current
+// while the measured phase currents are negligible
+func (wb *Warp2) paused() bool {
+	if wb.pausedCurrent == 0 {
+		return false
+	}
+
+	if !wb.disabled() {
+		return false
+	}
+
+	res, err := wb.meterValues()
+	if err != nil {
+		return false
+	}
+
+	return max(math.Abs(res[3]), math.Abs(res[4]), math.Abs(res[5])) < wb.pausedCurrent
+}
+
+// sessionConflict detects charging sessions started by nfc tag at the box while evcc requests the charger disabled.
+// Depending on configuration, control is yielded to the box for the duration of the session.
+func (wb *Warp2) sessionConflict(status api.ChargeStatus) {
+	var res warp.ChargeTrackerCurrentCharge
+
+	s, err := wb.chargeG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	conflict := err == nil && status == api.StatusC && res.AuthorizationType == warp.AuthorizationNfc &&
+		!wb.requested.Updated.IsZero() && wb.requested.Current == 0
+
+	// keep yielded control until the session has ended
+	if conflict == wb.conflict || (wb.conflict && wb.yieldConflict && status != api.StatusA) {
+		return
+	}
+
+	wb.conflict = conflict
+
+	if !wb.yieldConflict {
+		if conflict {
+			wb.log.WARN.Println("nfc session conflict: charging started at the box while disabled by evcc")
+		}
+		return
+	}
+
+	enabled := !conflict
+	if conflict {
+		wb.log.WARN.Println("nfc session conflict: charging started at the box, yielding control until session ends")
+	} else {
+		wb.log.INFO.Println("nfc session conflict: session ended, reclaiming control")
+	}
+
+	if err := wb.externalEnabledS(enabled); err != nil {
+		wb.log.ERROR.Printf("nfc session conflict: %v", err)
+	}
+}
+
+// transientFault implements the api.FaultClassifier interface
+func (wb *Warp2) transientFault() (bool, error) {
+	// residual current monitor trips require intervention
+	if fault, ok := wb.dcFaultCurrentState(); ok && fault != warp.DcFaultCurrentOk {
+		return false, nil
+	}
+
+	var res warp.EvseState
+
+	s, err := wb.statusG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	// vehicle communication errors usually recover, switch and contactor errors do not
+	return res.ErrorState == warp.ErrorStateCommunication, err
+}
+
+type errorClearSettings struct {
+	Auto     bool          // reset evse errors automatically
+	Attempts int           // automatic resets per fault before giving up
+	Backoff  time.Duration // delay before the first automatic reset, doubled per attempt
+}
+
+// errorClear tracks automatic error resets. It has a separate lock as the reset waits for the evse state.
+type errorClear struct {
+	errorClearSettings
+	timeout, interval time.Duration // readback
+	mu                sync.Mutex
+	running           bool // reset attempts in progress
+	exhausted         bool // attempts failed, wait for the error to clear otherwise
+}
+
+// ClearError requests the evse to reset and waits for the error state to clear. DC fault current monitor
+// trips are not reset and require intervention.
+func (wb *Warp2) ClearError() error {
+	return wb.clearError(wb.errorClear.timeout, wb.errorClear.interval)
+}
+
+// evseError returns the evse error state
+func (wb *Warp2) evseError() (int, error) {
+	var res warp.EvseState
+
+	s, err := wb.statusG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	return res.ErrorState, err
+}
+
+// clearError publishes the reset command and waits for the box reporting no error
+func (wb *Warp2) clearError(timeout, interval time.Duration) error {
+	state, err := wb.evseError()
+	if err != nil {
+		return err
+	}
+
+	if state == warp.ErrorStateOk {
+		return nil
+	}
+
+	wb.log.INFO.Printf("evse error %d: requesting reset", state)
+
+	if err := wb.resetS(); err != nil {
+		return err
+	}
+
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(interval) {
+		// box may be unavailable while resetting
+		if state, err = wb.evseError(); err == nil && state == warp.ErrorStateOk {
+			wb.log.INFO.Println("evse error: cleared")
+			return nil
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("evse error: reset not confirmed: %w", err)
+	}
+
+	return fmt.Errorf("evse error %d: not cleared by reset", state)
+}
+
+// autoClear starts resetting the evse on error state. Resets are retried with increasing delay up to the
+// configured attempts. Persistent errors are left to the user until they clear.
+func (wb *Warp2) autoClear(payload string) {
+	var res warp.EvseState
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return
+	}
+
+	c := &wb.errorClear
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if res.ErrorState == warp.ErrorStateOk {
+		c.exhausted = false
+		return
+	}
+
+	if c.running || c.exhausted {
+		return
+	}
+
+	c.running = true
+	go wb.clearAttempts()
+}
+
+// clearAttempts resets the evse until the error cleared or the attempts are exhausted
+func (wb *Warp2) clearAttempts() {
+	c := &wb.errorClear
+
+	exhausted := true
+	for attempt := 0; attempt < c.Attempts; attempt++ {
+		time.Sleep(c.Backoff << attempt)
+
+		state, err := wb.evseError()
+		if err == nil && state == warp.ErrorStateOk {
+			exhausted = false
+			break
+		}
+
+		wb.log.INFO.Printf("evse error: automatic reset %d/%d", attempt+1, c.Attempts)
+
+		if err = wb.clearError(c.timeout, c.interval); err == nil {
+			exhausted = false
+			break
+		}
+
+		wb.log.WARN.Printf("evse error: automatic reset %d/%d failed: %v", attempt+1, c.Attempts, err)
+	}
+
+	if exhausted {
+		wb.log.ERROR.Printf("evse error: not cleared after %d resets, check the box", c.Attempts)
+	}
+
+	c.mu.Lock()
+	c.running, c.exhausted = false, exhausted
+	c.mu.Unlock()
+}
+
+// dcFaultCurrentState returns the DC fault current monitor state if supported by the firmware
+func (wb *Warp2) dcFaultCurrentState() (warp.DcFaultCurrentState, bool) {
+	var res warp.EvseState
+
+	s, err := wb.statusG()
+	if err != nil || wb.unmarshal(s, &res) != nil || res.DcFaultCurrentState == nil {
+		return warp.DcFaultCurrentOk, false
+	}
+
+	fault := *res.DcFaultCurrentState
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	if fault != wb.dcFault {
+		if fault != warp.DcFaultCurrentOk {
+			wb.log.ERROR.Printf("dc fault current: %s", fault)
+		} else {
+			wb.log.INFO.Println("dc fault current: cleared")
+		}
+		wb.dcFault = fault
+	}
+
+	return fault, true
+}
+
+// MaxCurrent implements the api.Charger interface
+func (wb *Warp2) MaxCurrent(current int64) error {
+	return wb.MaxCurrentMillis(float64(current))
+}
+
+var _ api.ChargerEx = (*Warp2)(nil)
+
+// MaxCurrentMillis implements the api.ChargerEx interface.
+// Limits apply in order: the current requested by evcc is capped by the grid limit and rounded down to the
+// current resolution. The box then applies the minimum of all its slots, e.g. cable and installer limits.
+func (wb *Warp2) MaxCurrentMillis(current float64) error {
+	curr := int64(current * 1e3)
+	if wb.gridLimit > 0 && curr > wb.gridLimit {
+		wb.log.DEBUG.Printf("current %dmA limited by grid limit: %dmA", curr, wb.gridLimit)
+		curr = wb.gridLimit
+	}
+
+	// cooperate with the load management of the energy manager
+	if alloc, ok := wb.allocatedCurrent(); ok && curr > alloc {
+		wb.log.DEBUG.Printf("current %dmA limited by charge manager allocation: %dmA", curr, alloc)
+		curr = alloc
+	}
+
+	// some firmware applies whole amps only, round down to configured resolution
+	curr = curr / wb.resolution * wb.resolution
+
+	// external current doubles as enable, keep disabled box disabled until Enable applies the current
+	if wb.disabled() || wb.holding() {
+		wb.current = curr
+		return nil
+	}
+
+	err := wb.setCurrent(curr, false)
+	if err == nil {
+		wb.current = curr
+	}
+	return err
+}
+
+// MaxCurrentSigned sets a signed current on V2G capable hardware. Negative currents request discharging.
+func (wb *Warp2) MaxCurrentSigned(current float64) error {
+	if !wb.bidirectional {
+		return api.ErrNotAvailable
+	}
+
+	curr := int64(current * 1e3)
+	if wb.gridLimit > 0 {
+		curr = max(min(curr, wb.gridLimit), -wb.gridLimit)
+	}
+
+	// round towards zero to configured resolution
+	curr = curr / wb.resolution * wb.resolution
+
+	return wb.signedCurrentS(curr)
+}
+
+var _ api.CurrentLimiter = (*Warp2)(nil)
+
+// GetMinMaxCurrent implements the api.CurrentLimiter interface
+func (wb *Warp2) GetMinMaxCurrent() (float64, float64, error) {
+	minCurrent := wb.minCurrent()
+
+	maxCurrent, _ := wb.ceiling()
+	if wb.gridLimit > 0 {
+		maxCurrent = min(maxCurrent, wb.gridLimit)
+	}
+
+	return float64(minCurrent) / 1e3, float64(maxCurrent) / 1e3, nil
+}
+
+// minCurrent returns the minimum charging current configured at the box. Defaults to 6A if not reported.
+func (wb *Warp2) minCurrent() int64 {
+	var res warp.EvseMinChargingCurrent
+
+	if s, err := wb.mincurrentG(); err == nil {
+		if err := wb.unmarshal(s, &res); err == nil {
+			// box may report implausible values if unconfigured
+			if res.Current > 0 && res.Current <= warp.MaxCurrent {
+				return res.Current
+			}
+			wb.log.DEBUG.Printf("invalid min current: %dmA", res.Current)
+		}
+	}
+
+	return warp.MinCurrent
+}
+
+// ceiling returns the maximum current the box can deliver and what limits it. The installer configured
+// supply rating is further limited by the rating of a connected cable. Defaults to 32A if not reported.
+func (wb *Warp2) ceiling() (int64, string) {
+	res, source := int64(warp.MaxCurrent), "default"
+
+	if slots, err := wb.Slots(); err == nil {
+		for _, s := range slots {
+			if s.Index == warp.SlotIncomingCable && s.Current > 0 && s.Current < res {
+				res, source = s.Current, s.Name
+			}
+		}
+	}
+
+	var lowLevel warp.LowLevelState
+	if s, err := wb.lowLevelG(); err == nil && wb.unmarshal(s, &lowLevel) == nil {
+		if _, _, ppResistance, ok := lowLevel.Pilot(); ok {
+			if rating, ok := warp.CableRating(ppResistance); ok && int64(rating)*1e3 < res {
+				res, source = int64(rating)*1e3, "cable"
+			}
+		}
+	}
+
+	return res, source
+}
+
+var _ api.FeatureDescriber = (*Warp2)(nil)
+
+// Features implements the api.FeatureDescriber interface
+func (wb *Warp2) Features() []api.Feature {
+	if wb.resolution >= 1000 {
+		return []api.Feature{api.CoarseCurrent}
+	}
+	return nil
+}
+
+// CurrentPower implements the api.Meter interface
+func (wb *Warp2) currentPower() (float64, error) {
+	var res warp.MeterValues
+
+	s, err := wb.meterG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	return wb.power(res.Power), err
+}
+
+// power normalizes meter power to positive when charging and negative when discharging
+func (wb *Warp2) power(p float64) float64 {
+	if wb.invertPower {
+		return -p
+	}
+	return p
+}
+
+// phasePower implements the api.Meter interface by summing the phase powers. The meter's total power register
+// is sampled independently and may deviate slightly, summing phases is preferable if the total register is unreliable.
+func (wb *Warp2) phasePower() (float64, error) {
+	if wb.phasesG != nil {
+		res, err := wb.phaseMeter()
+		return res[0].Power + res[1].Power + res[2].Power, err
+	}
+
+	res, err := wb.meterValues()
+	if err != nil {
+		return 0, err
+	}
+
+	// phase active power values (6-8)
+	if len(res) <= 8 {
+		return 0, errors.New("invalid length")
+	}
+
+	return res[6] + res[7] + res[8], nil
+}
+
+// TotalEnergy implements the api.MeterEnergy interface
+func (wb *Warp2) totalEnergy() (float64, error) {
+	var res warp.MeterValues
+
+	s, err := wb.meterG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	return res.EnergyAbs, err
+}
+
+// chargedEnergy implements the api.ChargeRater interface. The box tracks the session across evcc restarts,
+// energy charged before startup is offset by the loadpoint.
+func (wb *Warp2) chargedEnergy() (float64, error) {
+	var res warp.ChargeTrackerCurrentCharge
+
+	s, err := wb.chargeG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if res.UserID == warp.UserNone {
+		return 0, nil
+	}
+
+	total, err := wb.totalEnergy()
+	if err != nil {
+		return 0, err
+	}
+
+	return max(total-res.MeterStart, 0), nil
+}
+
+type meterMissingSettings struct {
+	Timeout time.Duration // warn if the advertised meter provides no data
+	Demote  bool          // estimate power from the confirmed current instead of failing
+}
+
+// meterWatch tracks meter data outages. It has a separate lock as power is estimated using the charger lock.
+type meterWatch struct {
+	meterMissingSettings
+	mu      sync.Mutex
+	since   time.Time // first failed read since last data
+	missing bool
+}
+
+// watchMeter wraps the power getter to detect a meter not providing data and optionally fall back to
+// the estimated power. Meter data resuming restores measured power.
+func (wb *Warp2) watchMeter(g func() (float64, error)) func() (float64, error) {
+	return func() (float64, error) {
+		res, err := g()
+		if wb.meterMissing(err) {
+			return wb.estimatedPower()
+		}
+		return res, err
+	}
+}
+
+// meterMissing updates the outage state by the read result and returns true if power should be estimated
+func (wb *Warp2) meterMissing(err error) bool {
+	w := &wb.meterWatch
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err == nil {
+		if w.missing {
+			wb.log.INFO.Println("meter: data resumed")
+		}
+		w.since, w.missing = time.Time{}, false
+		return false
+	}
+
+	if w.since.IsZero() {
+		w.since = time.Now()
+	}
+
+	if !w.missing && time.Since(w.since) >= w.Timeout {
+		w.missing = true
+
+		if w.Demote {
+			wb.log.WARN.Printf("meter: no data for %v despite meter feature, check meter connection. Estimating power from set current: %v", w.Timeout, err)
+		} else {
+			wb.log.WARN.Printf("meter: no data for %v despite meter feature, check meter connection: %v", w.Timeout, err)
+		}
+	}
+
+	return w.missing && w.Demote
+}
+
+// estimatedPower returns the power from the confirmed current like for boxes without meter
+func (wb *Warp2) estimatedPower() (float64, error) {
+	status, err := wb.status()
+	if err != nil || status != api.StatusC {
+		return 0, err
+	}
+
+	phases := 3
+	if p, err := wb.getPhases(); err == nil {
+		phases = p
+	}
+
+	wb.mu.Lock()
+	current := wb.confirmed.Current
+	wb.mu.Unlock()
+
+	return current * wb.nominalVoltage * float64(phases), nil
+}
+
+func (wb *Warp2) meterValues() ([]float64, error) {
+	s, err := wb.meterDetailsG()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []float64
+	if err := wb.unmarshal(s, &res); err != nil {
+		return nil, err
+	}
+
+	if len(res) <= 5 {
+		return nil, errors.New("invalid length")
+	}
+
+	// phase active power values (6-8)
+	for i := 6; i < min(len(res), 9); i++ {
+		res[i] = wb.power(res[i])
+	}
+
+	return res, nil
+}
+
+// meterValue returns the meter value by value id. Values are only located by the published value ids
+// as positions in all_values differ between meter types.
+func (wb *Warp2) meterValue(id int) (float64, error) {
+	var ids []int
+
+	s, err := wb.valueIdsG()
+	if err == nil {
+		err = wb.unmarshal(s, &ids)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	idx, ok := warp.ValueIndex(ids, id)
+	if !ok {
+		return 0, api.ErrNotAvailable
+	}
+
+	res, err := wb.meterValues()
+	if err != nil {
+		return 0, err
+	}
+
+	if idx >= len(res) {
+		return 0, fmt.Errorf("invalid value index: %d", idx)
+	}
+
+	return res[idx], nil
+}
+
+// PowerFactor returns the total power factor
+func (wb *Warp2) PowerFactor() (float64, error) {
+	return wb.meterValue(warp.ValueIdPowerFactor)
+}
+
+// Frequency returns the line frequency
+func (wb *Warp2) Frequency() (float64, error) {
+	return wb.meterValue(warp.ValueIdFrequency)
+}
+
+// phaseMeter returns the warp3 phase values
+func (wb *Warp2) phaseMeter() (warp.PhaseMeter, error) {
+	var res []warp.MeterPhase
+
+	s, err := wb.phasesG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+	if err != nil {
+		return warp.PhaseMeter{}, err
+	}
+
+	for i := range res {
+		res[i].Power = wb.power(res[i].Power)
+	}
+
+	return warp.NewPhaseMeter(res)
+}
+
+// currents implements the api.MeterCurrrents interface
+func (wb *Warp2) currents() (float64, float64, float64, error) {
+	if wb.phasesG != nil {
+		res, err := wb.phaseMeter()
+		if err != nil {
+			return 0, 0, 0, err
+		}
+
+		wb.crossCheck(max(res[0].Current, res[1].Current, res[2].Current))
+
+		if !wb.signedCurrents {
+			return res[0].Current, res[1].Current, res[2].Current, nil
+		}
+
+		return math.Copysign(res[0].Current, res[0].Power), math.Copysign(res[1].Current, res[1].Power), math.Copysign(res[2].Current, res[2].Power), nil
+	}
+
+	res, err := wb.meterValues()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	wb.crossCheck(max(res[3], res[4], res[5]))
+
+	if !wb.signedCurrents {
+		return res[3], res[4], res[5], nil
+	}
+
+	// current values (3-5) are magnitudes, direction is carried by the phase active power values (6-8)
+	if len(res) <= 8 {
+		return res[3], res[4], res[5], nil
+	}
+
+	return math.Copysign(res[3], res[6]), math.Copysign(res[4], res[7]), math.Copysign(res[5], res[8]), nil
+}
+
+// energies implements the api.PhaseEnergies interface
+func (wb *Warp2) energies() (float64, float64, float64, error) {
+	return wb.phaseValues(warp.ValueIdEnergyL1, warp.ValueIdEnergyL2, warp.ValueIdEnergyL3)
+}
+
+// phaseValues returns the per-phase meter values by value id
+func (wb *Warp2) phaseValues(ids ...int) (float64, float64, float64, error) {
+	var res [3]float64
+	for i, id := range ids[:len(res)] {
+		v, err := wb.meterValue(id)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		res[i] = v
+	}
+
+	return res[0], res[1], res[2], nil
+}
+
+// crossCheck warns once per occurrence if the measured phase current exceeds the set current while charging.
+// This indicates a wrong meter assignment or a box not applying the setpoint. Lower currents are
+// expected when the vehicle limits itself.
+func (wb *Warp2) crossCheck(measured float64) {
+	if wb.currentTolerance == 0 {
+		return
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	set := float64(wb.published.Current) / 1e3
+	mismatch := wb.lastStatus == api.StatusC && !wb.published.Updated.IsZero() && measured > set+wb.currentTolerance
+
+	if mismatch && !wb.checks.currentMismatch {
+		wb.log.WARN.Printf("measured current %.3gA exceeds set current %.3gA, check meter and wiring", measured, set)
+	}
+
+	wb.checks.currentMismatch = mismatch
+}
+
+// OfferedCurrent returns the current signalled to the vehicle by the control pilot duty cycle according to IEC 61851
+// or api.ErrNotAvailable if the firmware doesn't publish the duty cycle
+func (wb *Warp2) OfferedCurrent() (float64, error) {
+	var res warp.LowLevelState
+
+	s, err := wb.lowLevelG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if res.CpPwmDutyCycle == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	offered := warp.DutyCycleCurrent(*res.CpPwmDutyCycle)
+	wb.checkOffered(offered)
+
+	return offered, nil
+}
+
+// voltages implements the api.MeterVoltages interface
+func (wb *Warp2) voltages() (float64, float64, float64, error) {
+	var u1, u2, u3 float64
+
+	// line-to-line voltages are only located by value id
+	if wb.lineVoltages {
+		var err error
+		if u1, u2, u3, err = wb.phaseValues(warp.ValueIdVoltageL1L2, warp.ValueIdVoltageL2L3, warp.ValueIdVoltageL3L1); err != nil {
+			return 0, 0, 0, err
+		}
+	} else if wb.phasesG != nil {
+		res, err := wb.phaseMeter()
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		u1, u2, u3 = res[0].Voltage, res[1].Voltage, res[2].Voltage
+	} else {
+		res, err := wb.meterValues()
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		u1, u2, u3 = res[0], res[1], res[2]
+	}
+
+	wb.checkSag(u1, u2, u3)
+
+	return u1, u2, u3, nil
+}
+
+// nominal returns the nominal voltage matching the reported voltages
+func (wb *Warp2) nominal() float64 {
+	if wb.lineVoltages {
+		return wb.nominalVoltage * math.Sqrt(3)
+	}
+	return wb.nominalVoltage
+}
+
+// VoltageDeviation returns the per phase deviation of the measured from the nominal voltage in percent
+// or api.ErrNotAvailable if the meter does not provide phase voltages.
+// Sagging voltage explains charging power below current times nominal voltage.
+func (wb *Warp2) VoltageDeviation() (float64, float64, float64, error) {
+	if !wb.meterVoltages {
+		return 0, 0, 0, api.ErrNotAvailable
+	}
+
+	u1, u2, u3, err := wb.voltages()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	nominal := wb.nominal()
+
+	return warp.VoltageDeviation(u1, nominal), warp.VoltageDeviation(u2, nominal), warp.VoltageDeviation(u3, nominal), nil
+}
+
+func (wb *Warp2) identify() (string, error) {
+	var res warp.ChargeTrackerCurrentCharge
+
+	s, err := wb.chargeG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// cached box state, Status is not used since it takes control actions
+	var unplugged bool
+	if s, err := wb.statusG(); err == nil {
+		var state warp.EvseState
+		if err := wb.unmarshal(s, &state); err == nil {
+			status, err := evseStatus(state)
+			unplugged = err == nil && status == api.StatusA
+		}
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	// charge tracker may briefly report an empty tag, only clear once the session has ended
+	if id := res.AuthorizationInfo.TagId; id != "" {
+		wb.tagId = id
+	} else if unplugged {
+		wb.tagId = ""
+	}
+
+	return wb.tagId, nil
+}
+
+// emState returns the energy manager state. The state is streamed by the subscription,
+// the parsed result is cached until the payload changes.
+func (wb *Warp2) emState() (warp.EmState, error) {
+	s, err := wb.emStateG()
+	if err != nil {
+		return warp.EmState{}, err
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	if s != wb.emStatePayload {
+		var res warp.EmState
+		if err := wb.unmarshal(s, &res); err != nil {
+			return res, err
+		}
+
+		wb.emStatePayload = s
+		wb.emStateCache = res
+	}
+
+	return wb.emStateCache, nil
+}
+
+// iso15118State returns the vehicle data obtained via high level communication
+func (wb *Warp2) iso15118State() (warp.Iso15118State, error) {
+	var res warp.Iso15118State
+
+	if !wb.iso15118 {
+		return res, api.ErrNotAvailable
+	}
+
+	s, err := wb.iso15118G()
+	if err != nil {
+		return res, api.ErrNotAvailable
+	}
+
+	err = wb.unmarshal(s, &res)
+
+	return res, err
+}
+
+// vehicleSoc implements the api.Battery interface
+func (wb *Warp2) vehicleSoc() (float64, error) {
+	res, err := wb.iso15118State()
+	if err != nil {
+		return 0, err
+	}
+
+	if res.Soc == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	return *res.Soc, nil
+}
+
+// vehicleCurrent implements the api.VehicleCurrentGetter interface
+func (wb *Warp2) vehicleCurrent() (float64, error) {
+	res, err := wb.iso15118State()
+	if err != nil {
+		return 0, err
+	}
+
+	if res.MaxCurrent == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	return *res.MaxCurrent, nil
+}
+
+// getPhases implements the api.PhaseGetter interface
+func (wb *Warp2) getPhases() (int, error) {
+	if wb.singlePhase {
+		return 1, nil
+	}
+
+	if !wb.phaseSwitching {
+		return 0, api.ErrNotAvailable
+	}
+
+	res, err := wb.emState()
+	if err != nil {
+		return 0, err
+	}
+
+	// phases_switched is 1 or 3
+	if res.PhasesSwitched != 1 && res.PhasesSwitched != 3 {
+		return 0, fmt.Errorf("invalid phases: %d", res.PhasesSwitched)
+	}
+
+	return res.PhasesSwitched, nil
+}
+
+// checkPhaseSwitching warns if external control is unavailable and enforces the single phase installation constraint
+func (wb *Warp2) checkPhaseSwitching() {
+	res, err := wb.emState()
+	if err != nil {
+		wb.log.WARN.Printf("phase switching: %v", err)
+		return
+	}
+
+	if res.ExternalControl == warp.ExternalControlDeactivated {
+		wb.log.WARN.Printf("phase switching unavailable: %s", res.ExternalControl.Guidance())
+	}
+
+	if wb.singlePhase && res.PhasesSwitched != 1 {
+		wb.log.WARN.Printf("switched to %dp on single phase installation, switching to 1p", res.PhasesSwitched)
+		if err := wb.phasesS(1); err != nil {
+			wb.log.ERROR.Printf("phase switching: %v", err)
+		}
+	}
+}
+
+func (wb *Warp2) phases1p3p(phases int) error {
+	if wb.singlePhase && phases != 1 {
+		return fmt.Errorf("%dp not possible: installation configured for single phase", phases)
+	}
+
+	res, err := wb.emState()
+	if err != nil {
+		return err
+	}
+
+	if res.ExternalControl > 0 {
+		return fmt.Errorf("external control not available: %s", res.ExternalControl.String())
+	}
+
+	// switching interrupts the session, defer until charging stops
+	if wb.holdPhases {
+		status, err := wb.Status()
+		if err != nil {
+			return err
+		}
+
+		// loadpoint retries switching on its next cycle
+		if status == api.StatusC {
+			return fmt.Errorf("%dp deferred while charging: %w", phases, api.ErrMustRetry)
+		}
+	}
+
+	return wb.phasesS(int64(phases))
+}
+
+// releaseControl returns current control to the box's internal logic by disabling the external current slot
+func (wb *Warp2) releaseControl() {
+	if err := wb.publishSync(fmt.Sprintf("%s/evse/external_enabled_update", wb.root), `{ "enabled": false }`); err != nil {
+		wb.log.ERROR.Printf("release control: %v", err)
+	} else {
+		wb.log.INFO.Println("released control")
+	}
+}
+
+// publishSync publishes and waits for delivery as the application is about to exit
+func (wb *Warp2) publishSync(topic, payload string) error {
+	wb.log.TRACE.Printf("send %s: '%s'", topic, payload)
+
+	token := wb.writer.Client.Publish(topic, wb.writer.Qos, false, payload)
+	if !token.WaitTimeout(request.Timeout) {
+		return api.ErrTimeout
+	}
+
+	return token.Error()
+}
+
+var _ loadpoint.Controller = (*Warp2)(nil)
+
+// LoadpointControl implements loadpoint.Controller
+func (wb *Warp2) LoadpointControl(lp loadpoint.API) {
+	wb.mu.Lock()
+	wb.lp = lp
+	wb.mu.Unlock()
+
+	if wb.display.name.Publish {
+		go wb.publishDisplayName(lp.Title())
+	}
+
+	if wb.display.publishSoc {
+		done, stopped := make(chan struct{}), make(chan struct{})
+		go func() {
+			wb.displaySoc(lp, done)
+			close(stopped)
+		}()
+		shutdown.Register(func() {
+			close(done)
+			<-stopped
+		})
+	}
+}
+
+// Slots returns the active current limit slots
+func (wb *Warp2) Slots() ([]warp.Slot, error) {
+	var res []warp.EvseSlot
+
+	s, err := wb.slotsG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+
+	return warp.ActiveSlots(res), err
+}
+
+// authorized implements the api.AuthorizationStatus interface. With user management enabled, the user slot blocks
+// charging until a vehicle is authorized.
+func (wb *Warp2) authorized() (bool, error) {
+	slots, err := wb.Slots()
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range slots {
+		if s.Index == warp.SlotUser {
+			return s.Current > 0, nil
+		}
+	}
+
+	return true, nil
+}
+
+// allocatedCurrent returns the current allocated to the box by the charge manager if under load management
+func (wb *Warp2) allocatedCurrent() (int64, bool) {
+	slots, err := wb.Slots()
+	if err != nil {
+		return 0, false
+	}
+
+	for _, s := range slots {
+		if s.Index == warp.SlotChargeManager {
+			return s.Current, true
+		}
+	}
+
+	return 0, false
+}
+
+// nfcConfig is the last nfc configuration published by the box
+type nfcConfig struct {
+	mu      sync.Mutex
+	payload string
+	updated time.Time
+}
+
+func (wb *Warp2) updateNfcConfig(payload string) {
+	wb.nfcConfig.mu.Lock()
+	defer wb.nfcConfig.mu.Unlock()
+	wb.nfcConfig.payload, wb.nfcConfig.updated = payload, time.Now()
+}
+
+// RefreshTags requests the box to reload its authorized tags by writing back the unchanged nfc configuration.
+// Firmware caching the configuration applies changed tags only after reloading. Returns api.ErrNotAvailable
+// if the box has no nfc reader.
+func (wb *Warp2) RefreshTags() error {
+	return wb.refreshTags(warp.RefreshTimeout, warp.StopInterval)
+}
+
+// refreshTags writes the nfc configuration and waits for the box publishing the same tag list again
+func (wb *Warp2) refreshTags(timeout, interval time.Duration) error {
+	if !wb.nfc {
+		return api.ErrNotAvailable
+	}
+
+	wb.nfcConfig.mu.Lock()
+	payload := wb.nfcConfig.payload
+	wb.nfcConfig.mu.Unlock()
+
+	if payload == "" {
+		return fmt.Errorf("nfc config: %w", api.ErrTimeout)
+	}
+
+	var res warp.NfcConfig
+	if err := wb.unmarshal(payload, &res); err != nil {
+		return err
+	}
+
+	sent := time.Now()
+	if err := wb.writer.Publish(fmt.Sprintf("%s/nfc/config_update", wb.root), false, payload); err != nil {
+		return err
+	}
+
+	for deadline := time.Now().Add(timeout); time.Now().Before(deadline); time.Sleep(interval) {
+		wb.nfcConfig.mu.Lock()
+		payload, updated := wb.nfcConfig.payload, wb.nfcConfig.updated
+		wb.nfcConfig.mu.Unlock()
+
+		if updated.Before(sent) {
+			continue
+		}
+
+		var readback warp.NfcConfig
+		if err := wb.unmarshal(payload, &readback); err != nil {
+			return err
+		}
+
+		if !slices.Equal(res.AuthorizedTags, readback.AuthorizedTags) {
+			return errors.New("nfc config: tag list changed during reload")
+		}
+
+		wb.log.DEBUG.Printf("nfc config: reloaded %d tags", len(readback.AuthorizedTags))
+		return nil
+	}
+
+	return errors.New("nfc config: reload not confirmed")
+}
+
+// Profile returns the name of the active current profile or api.ErrNotAvailable if the firmware has no named profiles
+func (wb *Warp2) Profile() (string, error) {
+	var res warp.EvseProfile
+
+	s, err := wb.profileG()
+	if err != nil {
+		return "", api.ErrNotAvailable
+	}
+
+	if err := wb.unmarshal(s, &res); err != nil {
+		return "", err
+	}
+
+	if res.Name == "" {
+		return "", api.ErrNotAvailable
+	}
+
+	return res.Name, nil
+}
+
+// detectTemperature enables the temperature if the box publishes plausible readings within the timeout
+func (wb *Warp2) detectTemperature(timeout time.Duration) {
+	g, err := wb.topics.Getter("%s/evse/temperature", wb.root)
+	if err == nil {
+		err = wb.waitForData(timeout, "evse/temperature")
+	}
+	if err != nil {
+		wb.log.DEBUG.Printf("temperature: %v", err)
+		return
+	}
+
+	if _, err := wb.temperature(g); err != nil {
+		wb.log.DEBUG.Printf("temperature: %v", err)
+		return
+	}
+
+	wb.temperatureG.Store(&g)
+}
+
+// Temperature returns the box's internal temperature or api.ErrNotAvailable if not published by the firmware.
+// High temperatures indicate thermal derating of the charging current.
+func (wb *Warp2) Temperature() (float64, error) {
+	g := wb.temperatureG.Load()
+	if g == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	return wb.temperature(*g)
+}
+
+// temperature reads and validates the temperature
+func (wb *Warp2) temperature(g func() (string, error)) (float64, error) {
+	var res warp.EvseTemperature
+
+	s, err := g()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	t, ok := res.Celsius()
+	if !ok {
+		return 0, fmt.Errorf("invalid temperature: %s", s)
+	}
+
+	return t, nil
+}
+
+// Priority returns the load management priority configured on the box or api.ErrNotAvailable if not supported.
+// The priority is applied by the box's charge manager when distributing the group current and is independent of
+// the loadpoint priority which evcc uses for distributing surplus. Since evcc does not allocate circuit currents
+// itself, the value is informational only.
+func (wb *Warp2) Priority() (int, error) {
+	var res warp.EvseManagementPriority
+
+	s, err := wb.priorityG()
+	if err != nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	if err := wb.unmarshal(s, &res); err != nil {
+		return 0, err
+	}
+
+	if res.Priority == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	return *res.Priority, nil
+}
+
+// NotChargingReason returns why a connected vehicle is not charging
+func (wb *Warp2) NotChargingReason() (warp.NotCharging, error) {
+	var res warp.EvseState
+
+	s, err := wb.statusG()
+	if err == nil {
+		err = wb.unmarshal(s, &res)
+	}
+	if err != nil {
+		return warp.NotChargingNone, err
+	}
+
+	fault := res.Iec61851State == 4 || res.ErrorState != warp.ErrorStateOk
+	if state, ok := wb.dcFaultCurrentState(); ok && state != warp.DcFaultCurrentOk {
+		fault = true
+	}
+
+	// slots are not required for separating evcc and vehicle
+	slots, _ := wb.Slots()
+
+	return warp.NotChargingReason(res.Iec61851State, fault, wb.disabled(), slots), nil
+}
+
+var _ api.Diagnosis = (*Warp2)(nil)
+
+// Diagnose implements the api.Diagnosis interface
+func (wb *Warp2) Diagnose() {
+	broker := "dedicated"
+	if wb.shared {
+		broker = "shared"
+	}
+	fmt.Printf("\tBroker:\t%s (%s)\n", wb.client.Broker(), broker)
+	if wb.disconnected.Load() {
+		fmt.Printf("\tBroker connection:\tlost\n")
+	}
+	if wb.cadence != nil {
+		if interval, ok := wb.cadence.Interval(); ok {
+			fmt.Printf("\tTimeout:\t%v (update interval %v)\n", wb.cadence.Timeout(wb.timeout), interval.Round(time.Millisecond))
+		}
+	}
+	if id := wb.client.ClientID(); id != "" {
+		fmt.Printf("\tClient id:\t%s\n", id)
+	}
+	fmt.Printf("\tHardware:\t%s\n", wb.hardwareVariant())
+	if version, err := wb.firmwareVersion(); err == nil {
+		fmt.Printf("\tFirmware:\t%s\n", version)
+	}
+	if wb.writer != wb.client {
+		fmt.Printf("\tWrite broker:\t%s\n", wb.writer.Broker())
+	}
+
+	if fault, ok := wb.dcFaultCurrentState(); ok {
+		fmt.Printf("\tDC fault current:\t%s\n", fault)
+	}
+
+	if t, err := wb.Temperature(); err == nil {
+		fmt.Printf("\tTemperature:\t%.1f°C\n", t)
+	}
+
+	if reason, err := wb.NotChargingReason(); err == nil && reason != warp.NotChargingNone {
+		fmt.Printf("\tNot charging:\t%s\n", reason)
+	}
+
+	if w := &wb.meterWatch; w.Timeout > 0 {
+		w.mu.Lock()
+		if w.missing {
+			fmt.Printf("\tMeter data:\tmissing since %s (demoted: %t)\n", w.since.Format(time.TimeOnly), w.Demote)
+		}
+		w.mu.Unlock()
+	}
+
+	if wb.nfc {
+		wb.nfcConfig.mu.Lock()
+		var res warp.NfcConfig
+		if wb.unmarshal(wb.nfcConfig.payload, &res) == nil {
+			fmt.Printf("\tAuthorized tags:\t%d\n", len(res.AuthorizedTags))
+		}
+		wb.nfcConfig.mu.Unlock()
+	}
+
+	if slots, err := wb.Slots(); err == nil {
+		fmt.Printf("\tSlots:\n")
+		for _, s := range slots {
+			fmt.Printf("\t\t%d %s:\t%.3gA\n", s.Index, s.Name, float64(s.Current)/1e3)
+		}
+		if s, ok := warp.LimitingSlot(slots); ok {
+			fmt.Printf("\tLimited by:\t%s slot (%.3gA)\n", s.Name, float64(s.Current)/1e3)
+		}
+	}
+
+	if slices.Contains(wb.features, warp.FeatureMeterPhases) {
+		if pf, err := wb.PowerFactor(); err == nil {
+			fmt.Printf("\tPower factor:\t%.2f\n", pf)
+		}
+		if f, err := wb.Frequency(); err == nil {
+			fmt.Printf("\tFrequency:\t%.2fHz\n", f)
+		}
+	}
+
+	if d1, d2, d3, err := wb.VoltageDeviation(); err == nil {
+		fmt.Printf("\tVoltage deviation:\t%.1f%% %.1f%% %.1f%% (nominal %.0fV)\n", d1, d2, d3, wb.nominal())
+	}
+
+	if wb.emTopic != "" || wb.evsePhases {
+		if res, err := wb.emState(); err == nil {
+			fmt.Printf("\tExternal control:\t%s\n", res.ExternalControl)
+			if guidance := res.ExternalControl.Guidance(); guidance != "" {
+				fmt.Printf("\t\t%s\n", guidance)
+			}
+		}
+	}
+
+	wb.mu.Lock()
+	fmt.Printf("\tNFC session conflict:\t%t\n", wb.conflict)
+	if !wb.confirmed.Updated.IsZero() {
+		fmt.Printf("\tLast current confirmed:\t%.3gA at %s\n", wb.confirmed.Current, wb.confirmed.Updated.Format(time.TimeOnly))
+	}
+	wb.mu.Unlock()
+
+	wb.phaseCommand.mu.Lock()
+	if c := &wb.phaseCommand; !c.updated.IsZero() {
+		fmt.Printf("\tLast phase switch confirmed:\t%dp at %s\n", c.confirmed, c.updated.Format(time.TimeOnly))
+	}
+	if c := &wb.phaseCommand; c.requested != 0 {
+		fmt.Printf("\tPhase switch pending:\t%dp\n", c.requested)
+	}
+	wb.phaseCommand.mu.Unlock()
+
+	var lowLevel warp.LowLevelState
+	if s, err := wb.lowLevelG(); err == nil && wb.unmarshal(s, &lowLevel) == nil {
+		if lowLevel.ContactorCycles != nil {
+			fmt.Printf("\tContactor cycles:\t%d\n", *lowLevel.ContactorCycles)
+		}
+
+		if lowLevel.CpPwmDutyCycle != nil {
+			fmt.Printf("\tCP duty cycle:\t%.1f%% (offered %.3gA)\n", float64(*lowLevel.CpPwmDutyCycle)/10, warp.DutyCycleCurrent(*lowLevel.CpPwmDutyCycle))
+		}
+
+		if cp, pp, ppResistance, ok := lowLevel.Pilot(); ok {
+			fmt.Printf("\tCP voltage:\t%.2fV, state %s\n", float64(cp)/1e3, warp.PilotState(cp))
+			if rating, ok := warp.CableRating(ppResistance); ok {
+				fmt.Printf("\tPP:\t%.2fV, %dΩ, cable %dA\n", float64(pp)/1e3, ppResistance, rating)
+			} else {
+				fmt.Printf("\tPP:\t%.2fV, %dΩ, no cable or out of range (100Ω-1.5kΩ)\n", float64(pp)/1e3, ppResistance)
+			}
+		}
+	}
+
+	var ntp warp.NtpState
+	if g, err := wb.configGetter("ntp/state"); err == nil {
+		if s, err := g(); err == nil && wb.unmarshal(s, &ntp) == nil {
+			fmt.Printf("\tClock synchronized:\t%t\n", ntp.Synced)
+		}
+	}
+	var ntpConfig warp.NtpConfig
+	if g, err := wb.configGetter("ntp/config"); err == nil {
+		if s, err := g(); err == nil && wb.unmarshal(s, &ntpConfig) == nil {
+			fmt.Printf("\tTimezone:\t%s\n", ntpConfig.Timezone)
+		}
+	}
+
+	if res, err := wb.automationConfig(); err == nil {
+		fmt.Printf("\tBox schedules:\t%d\n", len(res.Schedules()))
+	}
+	if slots, err := wb.Slots(); err == nil {
+		for _, s := range slots {
+			if s.Index == warp.SlotAutomation {
+				fmt.Printf("\tSchedule active:\t%.3gA\n", float64(s.Current)/1e3)
+			}
+		}
+	}
+
+	if alloc, ok := wb.allocatedCurrent(); ok {
+		fmt.Printf("\tCharge manager allocation:\t%.3gA\n", float64(alloc)/1e3)
+	}
+
+	if profile, err := wb.Profile(); err == nil {
+		fmt.Printf("\tProfile:\t%s\n", profile)
+	}
+
+	if autoStart, err := wb.AutoStart(); err == nil {
+		fmt.Printf("\tAuto start:\t%t\n", autoStart)
+	}
+
+	if prio, err := wb.Priority(); err == nil {
+		fmt.Printf("\tLoad management priority:\t%d\n", prio)
+	}
+
+	if stats, err := wb.UserStats(); err == nil {
+		fmt.Printf("\tUser energy:\n")
+		for _, u := range stats {
+			fmt.Printf("\t\t%d %s:\t%.3fkWh (%d charges)\n", u.UserID, u.Name, u.Energy, u.Charges)
+		}
+	}
+
+	// event log is only fetched when diagnosing
+	if entries, err := wb.eventLog(); err != nil {
+		fmt.Printf("\tEvent log:\t%v\n", err)
+	} else {
+		fmt.Printf("\tEvent log:\n")
+		for _, e := range entries[max(0, len(entries)-warp.EventLogEntries):] {
+			ts := "-"
+			if !e.Time.IsZero() {
+				ts = e.Time.Format(time.DateTime)
+			}
+			fmt.Printf("\t\t%s\t%s\n", ts, e.Message)
+		}
+	}
+}
+
+// UserStats returns the energy charged per user. Charges are read from charge_tracker/last_charges, which holds
+// the recent charges kept by the box, and users from users/config.
+func (wb *Warp2) UserStats() ([]warp.UserStats, error) {
+	var users warp.UsersConfig
+
+	s, err := wb.userconfigG()
+	if err == nil {
+		err = wb.unmarshal(s, &users)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := wb.configGetter("charge_tracker/last_charges")
+	if err != nil {
+		return nil, err
+	}
+
+	var charges []warp.LastCharge
+	if s, err = g(); err == nil {
+		err = wb.unmarshal(s, &charges)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return warp.UserEnergies(users.Users, charges), nil
+}
+
+// eventLog reads the box's event log
+func (wb *Warp2) eventLog() ([]warp.EventLogEntry, error) {
+	g, err := wb.configGetter("event_log")
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := g()
+	if err != nil {
+		return nil, err
+	}
+
+	// payload may be a JSON string
+	var res string
+	if err := wb.unmarshal(s, &res); err == nil {
+		s = res
+	}
+
+	return warp.ParseEventLog(s), nil
 }
@@ -0,0 +1,166 @@
+package charger
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/charger/warp"
+)
+
+// checkSettings enables optional warnings, each subscribing to the box topics it checks
+type checkSettings struct {
+	Offered   bool // offered current differs from the setpoint
+	Clock     bool // box clock not synchronized
+	Schedules bool // box schedules override evcc
+}
+
+// checks holds the state of warnings issued once per occurrence
+type checks struct {
+	currentMismatch bool
+	offeredMismatch bool
+	offeredSince    time.Time
+	voltageSagged   bool
+	clockWarning    sync.Once
+}
+
+// checkClock warns once if the box clock is not synchronized
+func (wb *Warp2) checkClock(payload string) {
+	var res warp.NtpState
+	if err := wb.unmarshal(payload, &res); err != nil || res.Synced {
+		return
+	}
+
+	wb.checks.clockWarning.Do(func() {
+		wb.log.WARN.Println("box clock not synchronized, session times may be wrong")
+	})
+}
+
+// checkContactorCycles returns a listener warning once if the contactor cycle count exceeds the limit
+func (wb *Warp2) checkContactorCycles(limit int64) func(string) {
+	var once sync.Once
+
+	return func(payload string) {
+		var res warp.LowLevelState
+		if err := wb.unmarshal(payload, &res); err != nil || res.ContactorCycles == nil || *res.ContactorCycles < limit {
+			return
+		}
+
+		once.Do(func() {
+			wb.log.WARN.Printf("contactor cycles exceed %d: %d, consider maintenance", limit, *res.ContactorCycles)
+		})
+	}
+}
+
+// checkOffered warns once per occurrence if the offered current differs from the external current setpoint
+// limited by the box's active slots for longer than the grace period of setpoint changes. Without pwm,
+// e.g. no vehicle connected, nothing is offered.
+func (wb *Warp2) checkOffered(offered float64) {
+	var expected float64
+	mismatch := false
+
+	if offered > 0 {
+		var res warp.EvseExternalCurrent
+
+		s, err := wb.maxcurrentG()
+		if err == nil {
+			err = wb.unmarshal(s, &res)
+		}
+		if err != nil {
+			return
+		}
+
+		expected = float64(res.Current) / 1e3
+		if slots, err := wb.Slots(); err == nil {
+			if s, ok := warp.LimitingSlot(slots); ok {
+				expected = min(expected, float64(s.Current)/1e3)
+			}
+		}
+
+		mismatch = math.Abs(offered-expected) > warp.OfferedTolerance
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	if !mismatch {
+		wb.checks.offeredSince, wb.checks.offeredMismatch = time.Time{}, false
+		return
+	}
+
+	if wb.checks.offeredSince.IsZero() {
+		wb.checks.offeredSince = time.Now()
+	}
+
+	if !wb.checks.offeredMismatch && time.Since(wb.checks.offeredSince) >= warp.OfferedGrace {
+		wb.log.WARN.Printf("offered current %.3gA differs from set current %.3gA, check control pilot signalling", offered, expected)
+		wb.checks.offeredMismatch = true
+	}
+}
+
+// checkDutyCycle cross-checks the offered current of low level state updates
+func (wb *Warp2) checkDutyCycle(payload string) {
+	var res warp.LowLevelState
+	if err := wb.unmarshal(payload, &res); err == nil && res.CpPwmDutyCycle != nil {
+		wb.checkOffered(warp.DutyCycleCurrent(*res.CpPwmDutyCycle))
+	}
+}
+
+// checkSag warns once when a connected phase drops below nominal voltage by more than the sag threshold
+func (wb *Warp2) checkSag(voltages ...float64) {
+	if !wb.meterVoltages {
+		return
+	}
+
+	nominal := wb.nominal()
+
+	var sag float64
+	for _, u := range voltages {
+		// disconnected phases are not sagging
+		if d := warp.VoltageDeviation(u, nominal); u > 0 && d < sag {
+			sag = d
+		}
+	}
+
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+
+	sagged := -sag > wb.voltageSag
+
+	if sagged && !wb.checks.voltageSagged {
+		wb.log.WARN.Printf("voltage sag: %.1f%% below nominal %.0fV", -sag, nominal)
+	} else if !sagged && wb.checks.voltageSagged {
+		wb.log.INFO.Println("voltage sag: recovered")
+	}
+
+	wb.checks.voltageSagged = sagged
+}
+
+// automationConfig reads the box's automation tasks
+func (wb *Warp2) automationConfig() (warp.AutomationConfig, error) {
+	var res warp.AutomationConfig
+
+	g, err := wb.configGetter("automation/config")
+	if err == nil {
+		var s string
+		if s, err = g(); err == nil {
+			err = wb.unmarshal(s, &res)
+		}
+	}
+
+	return res, err
+}
+
+// checkSchedules warns about box schedules conflicting with evcc. The box's automation config is never written
+// since the firmware can only replace all rules, schedules must be removed by the user in the box's web interface.
+func (wb *Warp2) checkSchedules() {
+	res, err := wb.automationConfig()
+	if err != nil {
+		wb.log.DEBUG.Printf("automation: %v", err)
+		return
+	}
+
+	if schedules := res.Schedules(); len(schedules) > 0 {
+		wb.log.WARN.Printf("automation: %d box schedules may override the current set by evcc, remove them in the box's web interface", len(schedules))
+	}
+}
@@ -6,40 +6,42 @@ import (
 	"github.com/evcc-io/evcc/api"
 )
 
-func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error), identifier func() (string, error), phaseSwitcher func(int) error) api.Charger {
+func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func() (float64, error), phaseCurrents func() (float64, float64, float64, error), phaseVoltages func() (float64, float64, float64, error), identifier func() (string, error), phaseSwitcher func(int) error, chargeRater func() (float64, error), battery func() (float64, error), vehicleCurrentGetter func() (float64, error), phaseGetter func() (int, error), phaseEnergies func() (float64, float64, float64, error), authorizationStatus func() (bool, error), priceDisplay func(float64, string) error, faultClassifier func() (bool, error)) api.Charger {
+	if battery != nil && vehicleCurrentGetter == nil {
+		panic("decorateWarp2: api.Battery requires api.VehicleCurrentGetter")
+	}
+
+	if identifier != nil && authorizationStatus == nil {
+		panic("decorateWarp2: api.Identifier requires api.AuthorizationStatus")
+	}
+
+	if meter != nil && (meterEnergy == nil || chargeRater == nil) {
+		panic("decorateWarp2: api.Meter requires api.MeterEnergy, api.ChargeRater")
+	}
+
+	if phaseCurrents != nil && (phaseVoltages == nil || phaseEnergies == nil) {
+		panic("decorateWarp2: api.PhaseCurrents requires api.PhaseVoltages, api.PhaseEnergies")
+	}
+
+	if phaseSwitcher != nil && phaseGetter == nil {
+		panic("decorateWarp2: api.PhaseSwitcher requires api.PhaseGetter")
+	}
+
 	switch {
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return base
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
 			api.Meter
-		}{
-			Warp2: base,
-			Meter: &decorateWarp2MeterImpl{
-				meter: meter,
-			},
-		}
-
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
-		return &struct {
-			*Warp2
 			api.MeterEnergy
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
 			},
-		}
-
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
-		return &struct {
-			*Warp2
-			api.Meter
-			api.MeterEnergy
-		}{
-			Warp2: base,
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
@@ -48,253 +50,442 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
 		}{
 			Warp2: base,
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
 			api.Meter
+			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
-			api.PhaseCurrents
+			api.AuthorizationStatus
+			api.Identifier
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
 			api.Meter
 			api.MeterEnergy
-			api.PhaseCurrents
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
-			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
 			api.PhaseVoltages
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
 			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
 			api.PhaseVoltages
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
-			api.PhaseVoltages
+			api.PhaseGetter
+			api.PhaseSwitcher
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
 			api.Meter
 			api.MeterEnergy
-			api.PhaseVoltages
+			api.PhaseGetter
+			api.PhaseSwitcher
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
 			api.PhaseVoltages
 		}{
 			Warp2: base,
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
 			api.Meter
+			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
 			api.PhaseVoltages
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
-			api.PhaseCurrents
-			api.PhaseVoltages
+			api.AuthorizationStatus
+			api.Identifier
+			api.PhaseGetter
+			api.PhaseSwitcher
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
 			api.Meter
 			api.MeterEnergy
-			api.PhaseCurrents
-			api.PhaseVoltages
+			api.PhaseGetter
+			api.PhaseSwitcher
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
 			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
 			api.Identifier
 			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.Identifier
-			api.MeterEnergy
+			api.Battery
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
 			api.Meter
 			api.MeterEnergy
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
@@ -302,70 +493,121 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
 			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
 			api.Meter
+			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
 			api.Identifier
-			api.MeterEnergy
-			api.PhaseCurrents
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
-			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
 			api.Identifier
 			api.Meter
 			api.MeterEnergy
-			api.PhaseCurrents
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
@@ -375,75 +617,2430 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
 			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
 			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
 			api.Identifier
 			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
 			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
 			},
-		}
-
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.Identifier
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Identifier
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Identifier
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery == nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.Identifier
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.Identifier
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier == nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.FaultClassifier
+		}{
+			Warp2: base,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.FaultClassifier
+			api.PhaseGetter
+			api.PhaseSwitcher
+		}{
+			Warp2: base,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
+			api.PhaseGetter
+			api.PhaseSwitcher
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.PhaseVoltages
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.FaultClassifier
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
+			api.Identifier
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
+			api.Identifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.FaultClassifier
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
+		return &struct {
+			*Warp2
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
+			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
+		}{
+			Warp2: base,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Meter: &decorateWarp2MeterImpl{
+				meter: meter,
+			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
+		}
+
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.Identifier
-			api.MeterEnergy
+			api.Battery
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
 			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
@@ -451,86 +3048,185 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
 			api.Identifier
-			api.PhaseCurrents
-			api.PhaseVoltages
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Identifier
 			api.Meter
-			api.PhaseCurrents
-			api.PhaseVoltages
+			api.MeterEnergy
+			api.PhaseGetter
+			api.PhaseSwitcher
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
 			api.Identifier
-			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
-			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher == nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay == nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Identifier
 			api.Meter
 			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
+			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
@@ -543,133 +3239,251 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
+			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
+				phaseSwitcher: phaseSwitcher,
+			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.PhaseSwitcher
+			api.FaultClassifier
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
-			api.PhaseSwitcher
+			api.MeterEnergy
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
-			api.PhaseSwitcher
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
 			api.MeterEnergy
-			api.PhaseSwitcher
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
 			api.Meter
-			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.MeterEnergy
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
 			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
 			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
 			api.Meter
 			api.MeterEnergy
 			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
@@ -679,55 +3493,98 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.FaultClassifier
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
@@ -735,106 +3592,204 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
 			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.PhaseCurrents
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
 			api.Meter
-			api.PhaseCurrents
+			api.MeterEnergy
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
 		}
 
-	case identifier == nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.MeterEnergy
+			api.AuthorizationStatus
+			api.FaultClassifier
+			api.Identifier
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
 			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
 		}
 
-	case identifier == nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery == nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.ChargeRater
+			api.FaultClassifier
+			api.Identifier
 			api.Meter
 			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			Identifier: &decorateWarp2IdentifierImpl{
+				identifier: identifier,
+			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
@@ -844,78 +3799,139 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
-			api.PhaseSwitcher
+			api.Battery
+			api.FaultClassifier
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
-			api.PhaseSwitcher
+			api.MeterEnergy
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
-			api.MeterEnergy
-			api.PhaseSwitcher
+			api.Battery
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
 			api.MeterEnergy
-			api.PhaseSwitcher
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
@@ -923,86 +3939,169 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
 			api.Identifier
-			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Identifier
 			api.Meter
-			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.MeterEnergy
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
 			api.Identifier
-			api.MeterEnergy
 			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
-			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages == nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher == nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Identifier
 			api.Meter
 			api.MeterEnergy
 			api.PhaseCurrents
-			api.PhaseSwitcher
+			api.PhaseEnergies
+			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
@@ -1015,67 +4114,122 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
-			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
-				phaseSwitcher: phaseSwitcher,
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
+				phaseVoltages: phaseVoltages,
+			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.FaultClassifier
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
+			api.MeterEnergy
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
-			api.MeterEnergy
+			api.Battery
+			api.FaultClassifier
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
@@ -1083,20 +4237,39 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents == nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier == nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
-			api.Identifier
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Meter
 			api.MeterEnergy
+			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
-			Identifier: &decorateWarp2IdentifierImpl{
-				identifier: identifier,
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
@@ -1104,102 +4277,201 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			MeterEnergy: &decorateWarp2MeterEnergyImpl{
 				meterEnergy: meterEnergy,
 			},
+			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
+				phaseCurrents: phaseCurrents,
+			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
 			api.Identifier
-			api.PhaseCurrents
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy == nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents == nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Identifier
 			api.Meter
-			api.PhaseCurrents
+			api.MeterEnergy
+			api.PhaseGetter
 			api.PhaseSwitcher
-			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
 			Meter: &decorateWarp2MeterImpl{
 				meter: meter,
 			},
-			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
-				phaseCurrents: phaseCurrents,
+			MeterEnergy: &decorateWarp2MeterEnergyImpl{
+				meterEnergy: meterEnergy,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
 			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
-			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
-				phaseVoltages: phaseVoltages,
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
 			},
 		}
 
-	case identifier != nil && meter == nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter == nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.FaultClassifier
 			api.Identifier
-			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
-			MeterEnergy: &decorateWarp2MeterEnergyImpl{
-				meterEnergy: meterEnergy,
-			},
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 
-	case identifier != nil && meter != nil && meterEnergy != nil && phaseCurrents != nil && phaseSwitcher != nil && phaseVoltages != nil:
+	case battery != nil && faultClassifier != nil && identifier != nil && meter != nil && phaseCurrents != nil && phaseSwitcher != nil && priceDisplay != nil:
 		return &struct {
 			*Warp2
+			api.AuthorizationStatus
+			api.Battery
+			api.ChargeRater
+			api.FaultClassifier
 			api.Identifier
 			api.Meter
 			api.MeterEnergy
 			api.PhaseCurrents
+			api.PhaseEnergies
+			api.PhaseGetter
 			api.PhaseSwitcher
 			api.PhaseVoltages
+			api.PriceDisplay
+			api.VehicleCurrentGetter
 		}{
 			Warp2: base,
+			AuthorizationStatus: &decorateWarp2AuthorizationStatusImpl{
+				authorizationStatus: authorizationStatus,
+			},
+			Battery: &decorateWarp2BatteryImpl{
+				battery: battery,
+			},
+			ChargeRater: &decorateWarp2ChargeRaterImpl{
+				chargeRater: chargeRater,
+			},
+			FaultClassifier: &decorateWarp2FaultClassifierImpl{
+				faultClassifier: faultClassifier,
+			},
 			Identifier: &decorateWarp2IdentifierImpl{
 				identifier: identifier,
 			},
@@ -1212,18 +4484,62 @@ func decorateWarp2(base *Warp2, meter func() (float64, error), meterEnergy func(
 			PhaseCurrents: &decorateWarp2PhaseCurrentsImpl{
 				phaseCurrents: phaseCurrents,
 			},
+			PhaseEnergies: &decorateWarp2PhaseEnergiesImpl{
+				phaseEnergies: phaseEnergies,
+			},
+			PhaseGetter: &decorateWarp2PhaseGetterImpl{
+				phaseGetter: phaseGetter,
+			},
 			PhaseSwitcher: &decorateWarp2PhaseSwitcherImpl{
 				phaseSwitcher: phaseSwitcher,
 			},
 			PhaseVoltages: &decorateWarp2PhaseVoltagesImpl{
 				phaseVoltages: phaseVoltages,
 			},
+			PriceDisplay: &decorateWarp2PriceDisplayImpl{
+				priceDisplay: priceDisplay,
+			},
+			VehicleCurrentGetter: &decorateWarp2VehicleCurrentGetterImpl{
+				vehicleCurrentGetter: vehicleCurrentGetter,
+			},
 		}
 	}
 
 	return nil
 }
 
+type decorateWarp2AuthorizationStatusImpl struct {
+	authorizationStatus func() (bool, error)
+}
+
+func (impl *decorateWarp2AuthorizationStatusImpl) Authorized() (bool, error) {
+	return impl.authorizationStatus()
+}
+
+type decorateWarp2BatteryImpl struct {
+	battery func() (float64, error)
+}
+
+func (impl *decorateWarp2BatteryImpl) Soc() (float64, error) {
+	return impl.battery()
+}
+
+type decorateWarp2ChargeRaterImpl struct {
+	chargeRater func() (float64, error)
+}
+
+func (impl *decorateWarp2ChargeRaterImpl) ChargedEnergy() (float64, error) {
+	return impl.chargeRater()
+}
+
+type decorateWarp2FaultClassifierImpl struct {
+	faultClassifier func() (bool, error)
+}
+
+func (impl *decorateWarp2FaultClassifierImpl) TransientFault() (bool, error) {
+	return impl.faultClassifier()
+}
+
 type decorateWarp2IdentifierImpl struct {
 	identifier func() (string, error)
 }
@@ -1256,6 +4572,22 @@ func (impl *decorateWarp2PhaseCurrentsImpl) Currents() (float64, float64, float6
 	return impl.phaseCurrents()
 }
 
+type decorateWarp2PhaseEnergiesImpl struct {
+	phaseEnergies func() (float64, float64, float64, error)
+}
+
+func (impl *decorateWarp2PhaseEnergiesImpl) Energies() (float64, float64, float64, error) {
+	return impl.phaseEnergies()
+}
+
+type decorateWarp2PhaseGetterImpl struct {
+	phaseGetter func() (int, error)
+}
+
+func (impl *decorateWarp2PhaseGetterImpl) GetPhases() (int, error) {
+	return impl.phaseGetter()
+}
+
 type decorateWarp2PhaseSwitcherImpl struct {
 	phaseSwitcher func(int) error
 }
@@ -1271,3 +4603,19 @@ type decorateWarp2PhaseVoltagesImpl struct {
 func (impl *decorateWarp2PhaseVoltagesImpl) Voltages() (float64, float64, float64, error) {
 	return impl.phaseVoltages()
 }
+
+type decorateWarp2PriceDisplayImpl struct {
+	priceDisplay func(float64, string) error
+}
+
+func (impl *decorateWarp2PriceDisplayImpl) SetPrice(p0 float64, p1 string) error {
+	return impl.priceDisplay(p0, p1)
+}
+
+type decorateWarp2VehicleCurrentGetterImpl struct {
+	vehicleCurrentGetter func() (float64, error)
+}
+
+func (impl *decorateWarp2VehicleCurrentGetterImpl) GetVehicleCurrent() (float64, error) {
+	return impl.vehicleCurrentGetter()
+}
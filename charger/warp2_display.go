@@ -0,0 +1,142 @@
+package charger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/charger/warp"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/provider"
+	"golang.org/x/text/currency"
+)
+
+// display holds the values published on the box front panel
+type display struct {
+	name       displayNameSettings
+	publishSoc bool
+	soc        *int64
+	socS       func(int64) error
+	price      warp.Price
+	priceS     func(warp.Price) error
+}
+
+// displayNameSettings controls publishing the loadpoint title as the box's display name
+type displayNameSettings struct {
+	Publish   bool // publish loadpoint title
+	Overwrite bool // overwrite display name set by user
+}
+
+// displaySoc updates the front panel vehicle soc until done and clears it when evcc stops
+func (wb *Warp2) displaySoc(lp loadpoint.API, done <-chan struct{}) {
+	tick := time.NewTicker(warp.SocInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-done:
+			if wb.display.soc != nil && *wb.display.soc != warp.SocNone {
+				if err := wb.display.socS(warp.SocNone); err != nil {
+					wb.log.ERROR.Printf("soc: %v", err)
+				}
+			}
+			return
+		case <-tick.C:
+			wb.updateSoc(lp)
+		}
+	}
+}
+
+// updateSoc shows the vehicle soc known to evcc on the box front panel. The soc is cleared when the vehicle disconnects.
+func (wb *Warp2) updateSoc(lp loadpoint.API) {
+	soc := int64(warp.SocNone)
+	if f := lp.GetSoc(); f > 0 && lp.GetStatus() != api.StatusA {
+		soc = int64(math.Round(f))
+	}
+
+	if wb.display.soc != nil && *wb.display.soc == soc {
+		return
+	}
+
+	if err := wb.display.socS(soc); err != nil {
+		wb.log.ERROR.Printf("soc: %v", err)
+		return
+	}
+
+	wb.display.soc = &soc
+}
+
+// setPrice implements the api.PriceDisplay interface. The price is published if changed at the box's resolution.
+func (wb *Warp2) setPrice(price float64, cur string) error {
+	// minor currency unit, e.g. cent
+	digits := 2
+	if unit, err := currency.ParseISO(cur); err == nil {
+		digits, _ = currency.Standard.Rounding(unit)
+	}
+
+	res := warp.Price{Price: warp.DisplayPrice(price, digits), Currency: cur}
+
+	wb.mu.Lock()
+	unchanged := wb.display.price == res
+	wb.mu.Unlock()
+
+	if unchanged {
+		return nil
+	}
+
+	if err := wb.display.priceS(res); err != nil {
+		return err
+	}
+
+	wb.mu.Lock()
+	wb.display.price = res
+	wb.mu.Unlock()
+
+	return nil
+}
+
+// publishDisplayName sets the box's display name unless defined by the user
+func (wb *Warp2) publishDisplayName(title string) {
+	if title == "" {
+		return
+	}
+
+	get := func(topic string, res any) error {
+		g, err := provider.NewMqtt(wb.log, wb.client, fmt.Sprintf("%s/info/%s", wb.root, topic), wb.timeout).StringGetter()
+		if err == nil {
+			var s string
+			if s, err = g(); err == nil {
+				err = wb.unmarshal(s, res)
+			}
+		}
+		return err
+	}
+
+	var name warp.InfoName
+	var display warp.InfoDisplayName
+	if err := errors.Join(get("name", &name), get("display_name", &display)); err != nil {
+		wb.log.WARN.Printf("display name not supported: %v", err)
+		return
+	}
+
+	if display.DisplayName == title {
+		return
+	}
+
+	// display name defaults to host name unless changed by user
+	if display.DisplayName != name.Name && !wb.display.name.Overwrite {
+		wb.log.DEBUG.Printf("display name: keeping user-defined '%s'", display.DisplayName)
+		return
+	}
+
+	b, err := json.Marshal(warp.InfoDisplayName{DisplayName: title})
+	if err == nil {
+		err = wb.writer.Publish(fmt.Sprintf("%s/info/display_name_update", wb.root), false, string(b))
+	}
+	if err != nil {
+		wb.log.ERROR.Printf("display name: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package charger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/evcc-io/evcc/charger/warp"
+	"github.com/evcc-io/evcc/cmd/shutdown"
+	"github.com/evcc-io/evcc/provider"
+)
+
+// pinger tracks the pings of the broker round-trip check
+type pinger struct {
+	sent   int64
+	echoed atomic.Int64
+	set    func(int64) error
+}
+
+// keepalive periodically publishes to a topic ignored by the box and expects the broker to forward it back
+func (wb *Warp2) keepalive(interval time.Duration) error {
+	topic := fmt.Sprintf("%s/evcc/keepalive", wb.root)
+
+	if err := wb.client.Listen(topic, func(payload string) {
+		if v, err := strconv.ParseInt(payload, 10, 64); err == nil {
+			wb.pings.echoed.Store(v)
+		}
+	}); err != nil {
+		return err
+	}
+
+	var err error
+	wb.pings.set, err = provider.NewMqtt(wb.log, wb.client, topic, 0).IntSetter("ping")
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	shutdown.Register(func() { close(done) })
+
+	go func() {
+		tick := time.NewTicker(interval)
+		defer tick.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-tick.C:
+				wb.ping(time.Now().UnixNano())
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ping checks the previous ping has been forwarded by the broker and sends the next one
+func (wb *Warp2) ping(id int64) {
+	stalled := wb.pings.sent != 0 && wb.pings.echoed.Load() != wb.pings.sent
+
+	if wb.stalled.Swap(stalled) != stalled {
+		if stalled {
+			wb.log.WARN.Println("keepalive: broker stopped forwarding messages")
+		} else {
+			wb.log.INFO.Println("keepalive: broker recovered")
+		}
+	}
+
+	if err := wb.pings.set(id); err != nil {
+		wb.log.ERROR.Printf("keepalive: %v", err)
+	}
+
+	wb.pings.sent = id
+}
+
+// heartbeat blinks the indicator led while evcc is in control. The blink duration is limited,
+// the box returns the led to its internal logic if evcc stops publishing.
+func (wb *Warp2) heartbeat(done <-chan struct{}) {
+	topic := fmt.Sprintf("%s/evse/indicator_led_update", wb.root)
+	payload := fmt.Sprintf(`{ "indication": %d, "duration": %d }`, warp.IndicatorBlink, warp.IndicatorDuration.Milliseconds())
+
+	tick := time.NewTicker(warp.IndicatorInterval)
+	defer tick.Stop()
+
+	for {
+		if err := wb.writer.Publish(topic, false, payload); err != nil {
+			wb.log.ERROR.Printf("indicator led: %v", err)
+		}
+
+		select {
+		case <-done:
+			if err := wb.publishSync(topic, fmt.Sprintf(`{ "indication": %d, "duration": 0 }`, warp.IndicatorEvse)); err != nil {
+				wb.log.ERROR.Printf("indicator led: %v", err)
+			}
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// monitorSettings controls publishing a heartbeat for external monitoring
+type monitorSettings struct {
+	Topic    string        // heartbeat topic
+	Interval time.Duration // heartbeat interval
+}
+
+// monitor publishes the heartbeat until done
+func (wb *Warp2) monitor(settings monitorSettings, done <-chan struct{}) {
+	tick := time.NewTicker(settings.Interval)
+	defer tick.Stop()
+
+	for {
+		if payload, err := json.Marshal(wb.monitorPayload()); err != nil {
+			wb.log.ERROR.Printf("monitor: %v", err)
+		} else if err := wb.writer.Publish(settings.Topic, false, string(payload)); err != nil {
+			wb.log.ERROR.Printf("monitor: %v", err)
+		}
+
+		select {
+		case <-done:
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// SetVersion sets the evcc version reported by the monitoring heartbeat
+func (wb *Warp2) SetVersion(version string) {
+	wb.mu.Lock()
+	defer wb.mu.Unlock()
+	wb.version = version
+}
+
+// monitorPayload returns the heartbeat with loadpoint mode and last requested current
+func (wb *Warp2) monitorPayload() warp.Monitor {
+	wb.mu.Lock()
+	lp, requested, version := wb.lp, wb.requested, wb.version
+	wb.mu.Unlock()
+
+	res := warp.Monitor{
+		Version:   version,
+		Current:   requested.Current,
+		Timestamp: time.Now().Unix(),
+	}
+
+	if !requested.Updated.IsZero() {
+		res.SetpointTime = requested.Updated.Unix()
+	}
+
+	if lp != nil {
+		res.Mode = string(lp.GetMode())
+	}
+
+	return res
+}
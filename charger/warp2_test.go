@@ -0,0 +1,2070 @@
+package charger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/charger/warp"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getter returns a string getter serving the current value of s
+func getter(s *string) func() (string, error) {
+	return func() (string, error) {
+		return *s, nil
+	}
+}
+
+// unavailable is a string getter without data
+func unavailable() (string, error) {
+	return "", api.ErrTimeout
+}
+
+func TestWarp2IdentifyDebounce(t *testing.T) {
+	var charge, state string
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		chargeG:   getter(&charge),
+		statusG:   getter(&state),
+		lowLevelG: unavailable,
+	}
+
+	for _, tc := range []struct {
+		charge, state, expected string
+	}{
+		{`{"authorization_info":{"tag_id":""}}`, `{"iec61851_state":1}`, ""},
+		{`{"authorization_info":{"tag_id":"foo"}}`, `{"iec61851_state":1}`, "foo"},
+		// empty interstitial during session
+		{`{"authorization_info":{"tag_id":""}}`, `{"iec61851_state":2}`, "foo"},
+		{`{"authorization_info":{"tag_id":""}}`, `{"iec61851_state":1}`, "foo"},
+		{`{"authorization_info":{"tag_id":"bar"}}`, `{"iec61851_state":2}`, "bar"},
+		// session ended
+		{`{"authorization_info":{"tag_id":""}}`, `{"iec61851_state":0}`, ""},
+	} {
+		charge, state = tc.charge, tc.state
+
+		id, err := wb.identify()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, id, tc)
+	}
+}
+
+func TestWarp2IdentifyConflict(t *testing.T) {
+	charge := `{"authorization_type":2,"authorization_info":{"tag_id":"foo"}}`
+	state := `{"iec61851_state":2}`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		chargeG:       getter(&charge),
+		statusG:       getter(&state),
+		lowLevelG:     unavailable,
+		yieldConflict: true,
+		requested:     api.Setpoint{Updated: time.Now()},
+		externalEnabledS: func(bool) error {
+			require.Fail(t, "unexpected control action")
+			return nil
+		},
+	}
+
+	// identification does not resolve session conflicts
+	id, err := wb.identify()
+	require.NoError(t, err)
+	assert.Equal(t, "foo", id)
+	assert.False(t, wb.conflict)
+}
+
+func TestWarp2DcFaultCurrent(t *testing.T) {
+	var state string
+	lowLevel := `{"dc_fault_current_state":1}`
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		statusG:   getter(&state),
+		lowLevelG: getter(&lowLevel),
+		chargeG:   unavailable,
+	}
+
+	for _, tc := range []struct {
+		state    string
+		expected api.ChargeStatus
+	}{
+		// field not supported by firmware, low level state is ignored
+		{`{"iec61851_state":2}`, api.StatusC},
+		{`{"iec61851_state":2,"dc_fault_current_state":0}`, api.StatusC},
+		{`{"iec61851_state":2,"dc_fault_current_state":1}`, api.StatusF},
+		{`{"iec61851_state":2,"dc_fault_current_state":0}`, api.StatusC},
+	} {
+		state = tc.state
+
+		status, err := wb.Status()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, status, tc)
+	}
+}
+
+func TestWarp2Slots(t *testing.T) {
+	// slot count varies by firmware, unknown slots are reported by index
+	slots := `[{"max_current":32000,"active":true},{"max_current":0,"active":false},{"max_current":10000,"active":true},` +
+		`{},{},{},{},{},{},{},{},{},{},{},{},{"max_current":16000,"active":true}]`
+
+	wb := &Warp2{
+		log:    util.NewLogger("foo"),
+		slotsG: getter(&slots),
+	}
+
+	res, err := wb.Slots()
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, "slot 15", res[2].Name)
+
+	s, ok := warp.LimitingSlot(res)
+	require.True(t, ok)
+	assert.Equal(t, warp.Slot{Index: 2, Name: "interlock input", Current: 10000}, s)
+}
+
+func TestWarp2GridLimit(t *testing.T) {
+	var sent []int64
+
+	wb := &Warp2{
+		log:         util.NewLogger("foo"),
+		resolution:  1000,
+		gridLimit:   25000,
+		mincurrentG: unavailable,
+		slotsG:      unavailable,
+		lowLevelG:   unavailable,
+		maxcurrentS: func(current int64) error {
+			sent = append(sent, current)
+			return nil
+		},
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	require.NoError(t, wb.MaxCurrentMillis(32))
+	require.NoError(t, wb.MaxCurrentMillis(16.5))
+	assert.Equal(t, []int64{25000, 16000}, sent)
+
+	_, max, err := wb.GetMinMaxCurrent()
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, max)
+}
+
+func TestWarp2MinCurrent(t *testing.T) {
+	wb := &Warp2{
+		log:         util.NewLogger("foo"),
+		mincurrentG: unavailable,
+		slotsG:      unavailable,
+		lowLevelG:   unavailable,
+	}
+
+	for _, tc := range []struct {
+		payload  string
+		min, max float64
+	}{
+		{"", 6, 32},
+		{`{"current":8000}`, 8, 32},
+		{`{"current":0}`, 6, 32},
+		{`{"current":64000}`, 6, 32},
+	} {
+		if tc.payload != "" {
+			wb.mincurrentG = getter(&tc.payload)
+		}
+
+		min, max, err := wb.GetMinMaxCurrent()
+		require.NoError(t, err)
+		assert.Equal(t, tc.min, min, tc.payload)
+		assert.Equal(t, tc.max, max, tc.payload)
+	}
+}
+
+func TestWarp2EnabledMinCurrent(t *testing.T) {
+	minCurrent := `{"current":4000}`
+	current := `{"current":5000}`
+
+	wb := &Warp2{
+		log:         util.NewLogger("foo"),
+		mincurrentG: getter(&minCurrent),
+		maxcurrentG: getter(&current),
+	}
+
+	// 4A minimum box charging at 5A
+	enabled, err := wb.Enabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	current = `{"current":3000}`
+	enabled, err = wb.Enabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	// unknown minimum
+	wb.mincurrentG = unavailable
+	current = `{"current":5000}`
+	enabled, err = wb.Enabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	current = `{"current":6000}`
+	enabled, err = wb.Enabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestWarp2Ceiling(t *testing.T) {
+	wb := &Warp2{
+		log:         util.NewLogger("foo"),
+		mincurrentG: unavailable,
+		slotsG:      unavailable,
+		lowLevelG:   unavailable,
+	}
+
+	// not reported
+	_, max, err := wb.GetMinMaxCurrent()
+	require.NoError(t, err)
+	assert.Equal(t, 32.0, max)
+
+	slots := `[{"max_current":16000,"active":true}]`
+	wb.slotsG = getter(&slots)
+
+	_, max, err = wb.GetMinMaxCurrent()
+	require.NoError(t, err)
+	assert.Equal(t, 16.0, max)
+
+	// 13A cable
+	lowLevel := `{"adc_values":[],"voltages":[0,9000,0,0,1200],"resistances":[0,1500]}`
+	wb.lowLevelG = getter(&lowLevel)
+
+	ceiling, source := wb.ceiling()
+	assert.Equal(t, int64(13000), ceiling)
+	assert.Equal(t, "cable", source)
+}
+
+func TestWarp2SignedCurrents(t *testing.T) {
+	values := `[230,230,230,10,10,10,2300,-2300,0]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		meterDetailsG: getter(&values),
+	}
+
+	l1, l2, l3, err := wb.currents()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, 10, 10}, []float64{l1, l2, l3})
+
+	wb.signedCurrents = true
+
+	l1, l2, l3, err = wb.currents()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, -10, 10}, []float64{l1, l2, l3})
+}
+
+func TestWarp2EventLog(t *testing.T) {
+	log := `"      12,345  evse: started\n2024-03-05 10:22:33,456  nfc: tag seen\n"`
+
+	var s string
+	require.NoError(t, json.Unmarshal([]byte(log), &s))
+
+	res := warp.ParseEventLog(s)
+	require.Len(t, res, 2)
+	assert.True(t, res[0].Time.IsZero())
+	assert.Equal(t, "12,345  evse: started", res[0].Message)
+	assert.Equal(t, time.Date(2024, 3, 5, 10, 22, 33, 456e6, time.Local), res[1].Time)
+	assert.Equal(t, "nfc: tag seen", res[1].Message)
+}
+
+func TestWarp2TransientFault(t *testing.T) {
+	var state string
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		statusG:   getter(&state),
+		lowLevelG: unavailable,
+		chargeG:   unavailable,
+	}
+
+	for _, tc := range []struct {
+		state    string
+		expected bool
+	}{
+		{`{"iec61851_state":4,"error_state":5,"dc_fault_current_state":0}`, true},
+		{`{"iec61851_state":4,"error_state":4,"dc_fault_current_state":0}`, false},
+		{`{"iec61851_state":4,"error_state":5,"dc_fault_current_state":1}`, false},
+	} {
+		state = tc.state
+
+		status, err := wb.Status()
+		require.NoError(t, err)
+		assert.Equal(t, api.StatusF, status)
+
+		res, err := wb.transientFault()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, res, tc)
+	}
+}
+
+func TestWarp2Profile(t *testing.T) {
+	wb := &Warp2{
+		log:      util.NewLogger("foo"),
+		profileG: unavailable,
+	}
+
+	// firmware without named profiles
+	_, err := wb.Profile()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	profile := `{"name":"night"}`
+	wb.profileG = getter(&profile)
+
+	res, err := wb.Profile()
+	require.NoError(t, err)
+	assert.Equal(t, "night", res)
+}
+
+func TestWarp2Priority(t *testing.T) {
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		priorityG: unavailable,
+	}
+
+	// firmware without prioritized load management
+	_, err := wb.Priority()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	priority := `{"priority":null}`
+	wb.priorityG = getter(&priority)
+
+	_, err = wb.Priority()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	priority = `{"priority":2}`
+
+	res, err := wb.Priority()
+	require.NoError(t, err)
+	assert.Equal(t, 2, res)
+}
+
+func TestWarp2Authorized(t *testing.T) {
+	wb := &Warp2{
+		log:    util.NewLogger("foo"),
+		slotsG: unavailable,
+	}
+
+	_, err := wb.authorized()
+	assert.Error(t, err)
+
+	for _, tc := range []struct {
+		slots    string
+		expected bool
+	}{
+		// user management disabled
+		{`[{"max_current":32000,"active":true},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false}]`, true},
+		// waiting for tag
+		{`[{"max_current":32000,"active":true},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":true}]`, false},
+		// authorized
+		{`[{"max_current":32000,"active":true},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":32000,"active":true}]`, true},
+	} {
+		slots := tc.slots
+		wb.slotsG = getter(&slots)
+
+		res, err := wb.authorized()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, res, tc.slots)
+	}
+}
+
+func TestWarp2SkipUnchanged(t *testing.T) {
+	var sent []int64
+	fail := true
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		autoStartG: unavailable,
+		resolution: 1,
+		slotsG:     unavailable,
+		maxcurrentS: func(current int64) error {
+			sent = append(sent, current)
+			if fail {
+				fail = false
+				return api.ErrTimeout
+			}
+			return nil
+		},
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	// publish after error
+	require.Error(t, wb.MaxCurrentMillis(16))
+	require.NoError(t, wb.MaxCurrentMillis(16))
+	require.NoError(t, wb.MaxCurrentMillis(16))
+
+	// enable always asserts current
+	require.NoError(t, wb.Enable(true))
+
+	// re-assert after interval
+	wb.published.Updated = time.Now().Add(-warp.ReassertInterval)
+	require.NoError(t, wb.MaxCurrentMillis(16))
+
+	assert.Equal(t, []int64{16000, 16000, 16000, 16000}, sent)
+}
+
+func TestWarp2CoalesceCurrent(t *testing.T) {
+	var sent []int64
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		autoStartG: unavailable,
+		resolution: 1,
+		slotsG:     unavailable,
+	}
+	wb.maxcurrentS = warp.Coalesce(wb.log, time.Hour, wb.trackCurrent(func(current int64) error {
+		sent = append(sent, current)
+		return nil
+	}))
+
+	require.NoError(t, wb.MaxCurrentMillis(6))
+	require.NoError(t, wb.MaxCurrentMillis(16))
+
+	// deferred increase is not recorded as requested
+	assert.Equal(t, []int64{6000}, sent)
+	assert.Equal(t, 6.0, wb.requested.Current)
+
+	// disabling is not deferred
+	require.NoError(t, wb.Enable(false))
+	assert.Equal(t, []int64{6000, 0}, sent)
+	assert.True(t, wb.disabled())
+}
+
+func TestWarp2SessionConflict(t *testing.T) {
+	charge := `{"authorization_type":2}`
+	var published []bool
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		chargeG:       getter(&charge),
+		requested:     api.Setpoint{Current: 0, Updated: time.Now()},
+		yieldConflict: true,
+		externalEnabledS: func(enabled bool) error {
+			published = append(published, enabled)
+			return nil
+		},
+	}
+
+	for _, tc := range []struct {
+		status   api.ChargeStatus
+		conflict bool
+	}{
+		{api.StatusB, false},
+		{api.StatusC, true},
+		// yielded until session ended
+		{api.StatusB, true},
+		{api.StatusA, false},
+	} {
+		wb.sessionConflict(tc.status)
+		assert.Equal(t, tc.conflict, wb.conflict, tc)
+	}
+
+	assert.Equal(t, []bool{false, true}, published)
+}
+
+func TestWarp2MeterValue(t *testing.T) {
+	ids := `[1,2,3,13,17,21,364]`
+	values := `[230,230,230,10,10,10,50.01]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		valueIdsG:     getter(&ids),
+		meterDetailsG: getter(&values),
+	}
+
+	f, err := wb.Frequency()
+	require.NoError(t, err)
+	assert.Equal(t, 50.01, f)
+
+	// value id not published by meter
+	_, err = wb.PowerFactor()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+}
+
+func TestWarp2OfflineGrace(t *testing.T) {
+	state := `{"iec61851_state":2}`
+	online := true
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		statusG: func() (string, error) {
+			if !online {
+				return "", api.ErrTimeout
+			}
+			return state, nil
+		},
+		lowLevelG:    unavailable,
+		chargeG:      unavailable,
+		offlineGrace: time.Minute,
+	}
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	// blip
+	online = false
+	status, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	// outage
+	wb.lastStatusUpdated = time.Now().Add(-time.Minute)
+	_, err = wb.Status()
+	assert.ErrorIs(t, err, api.ErrTimeout)
+}
+
+func TestWarp2ChargeManagerAllocation(t *testing.T) {
+	slots := `[{"max_current":32000,"active":true},{},{},{},{},{},{},{"max_current":10000,"active":true}]`
+	var sent []int64
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		resolution: 1,
+		slotsG:     getter(&slots),
+		maxcurrentS: func(current int64) error {
+			sent = append(sent, current)
+			return nil
+		},
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	require.NoError(t, wb.MaxCurrentMillis(16))
+	assert.Equal(t, []int64{10000}, sent)
+}
+
+func TestWarp2FirmwareUpdate(t *testing.T) {
+	state := `{"iec61851_state":1}`
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		statusG:   getter(&state),
+		lowLevelG: unavailable,
+		chargeG:   unavailable,
+	}
+
+	wb.firmwareUpdate(`{"in_progress":true}`)
+	_, err := wb.Status()
+	assert.ErrorIs(t, err, api.ErrUpdating)
+
+	wb.firmwareUpdate(`{"in_progress":false}`)
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, status)
+}
+
+func TestWarp2MaxCurrentSigned(t *testing.T) {
+	var sent []int64
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		resolution: 1000,
+		gridLimit:  16000,
+		signedCurrentS: func(current int64) error {
+			sent = append(sent, current)
+			return nil
+		},
+	}
+
+	// unidirectional hardware
+	assert.ErrorIs(t, wb.MaxCurrentSigned(-10), api.ErrNotAvailable)
+
+	wb.bidirectional = true
+	require.NoError(t, wb.MaxCurrentSigned(-10.5))
+	require.NoError(t, wb.MaxCurrentSigned(-20))
+	assert.Equal(t, []int64{-10000, -16000}, sent)
+}
+
+func TestWarp2Soc(t *testing.T) {
+	var state string
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		iso15118G: getter(&state),
+	}
+
+	// no high level communication
+	_, err := wb.vehicleSoc()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	wb.iso15118 = true
+
+	// car doesn't supply soc
+	state = `{"soc":null}`
+	_, err = wb.vehicleSoc()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	state = `{"soc":57.5}`
+	soc, err := wb.vehicleSoc()
+	require.NoError(t, err)
+	assert.Equal(t, 57.5, soc)
+}
+
+func TestWarp2PausedAtC(t *testing.T) {
+	state := `{"iec61851_state":2}`
+	lowLevel := `{}`
+	values := `[230,230,230,0.1,0.2,0.1]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		statusG:       getter(&state),
+		lowLevelG:     getter(&lowLevel),
+		meterDetailsG: getter(&values),
+		chargeG:       unavailable,
+		pausedCurrent: 0.5,
+	}
+
+	// zero current not requested
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	wb.published = publishedCurrent{Current: 0, Updated: time.Now()}
+
+	status, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, status)
+
+	// still drawing current
+	values = `[230,230,230,6,6,6]`
+
+	status, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	// disabled by default
+	values = `[230,230,230,0,0,0]`
+	wb.pausedCurrent = 0
+
+	status, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+}
+
+func TestWarp2ChargeRelease(t *testing.T) {
+	var started int
+	autoStart := `{"auto_start_charging":true}`
+	slots := `[{"max_current":32000,"active":true},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":true}]`
+
+	wb := &Warp2{
+		log:         util.NewLogger("foo"),
+		current:     6000,
+		resolution:  1,
+		autoStartG:  getter(&autoStart),
+		slotsG:      getter(&slots),
+		maxcurrentS: func(int64) error { return nil },
+		startS:      func() error { started++; return nil },
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	res, err := wb.AutoStart()
+	require.NoError(t, err)
+	assert.True(t, res)
+
+	// box starts sessions itself
+	require.NoError(t, wb.Enable(true))
+	assert.Equal(t, 0, started)
+
+	// waiting for charge release
+	autoStart = `{"auto_start_charging":false}`
+	require.NoError(t, wb.Enable(true))
+	assert.Equal(t, 1, started)
+
+	// session already started
+	slots = `[{"max_current":32000,"active":true},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":0,"active":false},{"max_current":32000,"active":true}]`
+	require.NoError(t, wb.Enable(true))
+	assert.Equal(t, 1, started)
+}
+
+func TestWarp2NotChargingReason(t *testing.T) {
+	state := `{"iec61851_state":1,"error_state":0}`
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		statusG:   getter(&state),
+		slotsG:    unavailable,
+		lowLevelG: unavailable,
+	}
+
+	res, err := wb.NotChargingReason()
+	require.NoError(t, err)
+	assert.Equal(t, warp.NotChargingVehicle, res)
+
+	wb.published = publishedCurrent{Current: 0, Updated: time.Now()}
+
+	res, err = wb.NotChargingReason()
+	require.NoError(t, err)
+	assert.Equal(t, warp.NotChargingDisabled, res)
+
+	state = `{"iec61851_state":1,"error_state":4}`
+
+	res, err = wb.NotChargingReason()
+	require.NoError(t, err)
+	assert.Equal(t, warp.NotChargingFault, res)
+}
+
+func TestWarp2ConfirmPhases(t *testing.T) {
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+	}
+	wb.phasesS = wb.trackPhases(func(int64) error { return nil })
+
+	// not commanded by evcc
+	wb.confirmPhases(`{"phases_switched":3}`)
+	assert.True(t, wb.phaseCommand.updated.IsZero())
+
+	require.NoError(t, wb.phasesS(1))
+
+	// attempted only
+	wb.confirmPhases(`{"phases_switched":3}`)
+	assert.True(t, wb.phaseCommand.updated.IsZero())
+	assert.Equal(t, 1, wb.phaseCommand.requested)
+
+	wb.confirmPhases(`{"phases_switched":1}`)
+	assert.False(t, wb.phaseCommand.updated.IsZero())
+	assert.Equal(t, 1, wb.phaseCommand.confirmed)
+	assert.Zero(t, wb.phaseCommand.requested)
+
+	// failed commands are not tracked
+	wb.phasesS = wb.trackPhases(func(int64) error { return api.ErrTimeout })
+	assert.Error(t, wb.phasesS(3))
+	assert.Zero(t, wb.phaseCommand.requested)
+}
+
+func TestWarp2StopCharging(t *testing.T) {
+	var stopped int
+	charge := `{"user_id":-1}`
+
+	wb := &Warp2{
+		log:          util.NewLogger("foo"),
+		autoStartG:   unavailable,
+		current:      6000,
+		chargeG:      getter(&charge),
+		maxcurrentS:  func(int64) error { return nil },
+		stopS:        func() error { stopped++; return nil },
+		stopCharging: true,
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	assert.True(t, wb.confirmStop(0, 0))
+
+	charge = `{"user_id":0}`
+	assert.False(t, wb.confirmStop(0, 0))
+
+	require.NoError(t, wb.Enable(true))
+	require.NoError(t, wb.Enable(false))
+	assert.Equal(t, 1, stopped)
+}
+
+func TestWarp2WaitForData(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/state","payload":{"iec61851_state":1}}
+`), 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	wb := &Warp2{
+		log:    util.NewLogger("foo"),
+		client: client,
+		root:   "warp",
+	}
+
+	require.NoError(t, wb.waitForData(time.Second, "evse/state"))
+	assert.ErrorContains(t, wb.waitForData(100*time.Millisecond, "evse/state", "evse/external_current"), "warp/evse/external_current")
+}
+
+func TestWarp2Minimal(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/low_level_state","payload":{}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/state","payload":{"iec61851_state":1}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/external_current","payload":{"current":16000}}
+`), 0o644))
+	t.Setenv("EVCC_WARP_REPLAY", file)
+
+	c, err := NewWarp2FromConfig(map[string]any{"minimal": true})
+	require.NoError(t, err)
+
+	_, ok := c.(api.ChargerEx)
+	assert.True(t, ok)
+	_, ok = c.(api.Meter)
+	assert.False(t, ok)
+	_, ok = c.(api.Diagnosis)
+	assert.False(t, ok)
+	_, ok = c.(api.CurrentLimiter)
+	assert.True(t, ok)
+
+	require.Eventually(t, func() bool {
+		status, err := c.Status()
+		return err == nil && status == api.StatusB
+	}, time.Second, 10*time.Millisecond)
+
+	enabled, err := c.Enabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestWarp2ChargedEnergy(t *testing.T) {
+	charge := `{"user_id":-1,"meter_start":100.5}`
+	values := `{"energy_abs":112.5}`
+
+	wb := &Warp2{
+		log:     util.NewLogger("foo"),
+		chargeG: getter(&charge),
+		meterG:  getter(&values),
+	}
+
+	// no active charge
+	res, err := wb.chargedEnergy()
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, res)
+
+	charge = `{"user_id":0,"meter_start":100.5}`
+	res, err = wb.chargedEnergy()
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, res)
+}
+
+func TestWarp2Decorators(t *testing.T) {
+	for _, tc := range []struct {
+		features                 string
+		price                    bool
+		battery, phaseMeter, nfc bool
+	}{
+		{`["evse"]`, false, false, false, false},
+		{`["evse"]`, true, false, false, false},
+		{`["evse","iso15118"]`, false, true, false, false},
+		{`["evse","meter","meter_phases"]`, false, false, true, false},
+		{`["evse","nfc"]`, false, false, false, true},
+		{`["meter"]`, false, false, false, false},
+	} {
+		file := filepath.Join(t.TempDir(), "capture.jsonl")
+		require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/low_level_state","payload":{}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/features","payload":`+tc.features+`}
+`), 0o644))
+		t.Setenv("EVCC_WARP_REPLAY", file)
+
+		c, err := NewWarp2FromConfig(map[string]any{"timeout": "100ms", "publishprice": tc.price})
+		require.NoError(t, err)
+
+		_, ok := c.(api.FaultClassifier)
+		assert.Equal(t, strings.Contains(tc.features, `"evse"`), ok, tc.features)
+		_, ok = c.(api.PriceDisplay)
+		assert.Equal(t, tc.price, ok, tc.features)
+		_, ok = c.(api.Battery)
+		assert.Equal(t, tc.battery, ok, tc.features)
+		_, ok = c.(api.VehicleCurrentGetter)
+		assert.Equal(t, tc.battery, ok, tc.features)
+		_, ok = c.(api.PhaseEnergies)
+		assert.Equal(t, tc.phaseMeter, ok, tc.features)
+		_, ok = c.(api.AuthorizationStatus)
+		assert.Equal(t, tc.nfc, ok, tc.features)
+	}
+}
+
+func TestWarp2EnableCurrent(t *testing.T) {
+	var sent []int64
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		autoStartG: unavailable,
+		current:    10000,
+		resolution: 1,
+		slotsG:     unavailable,
+		maxcurrentS: func(current int64) error {
+			sent = append(sent, current)
+			return nil
+		},
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	// configured enable current before any current was set
+	require.NoError(t, wb.Enable(true))
+	require.NoError(t, wb.Enable(false))
+
+	// current is applied with enable
+	require.NoError(t, wb.MaxCurrentMillis(16))
+	require.NoError(t, wb.Enable(true))
+
+	assert.Equal(t, []int64{10000, 0, 16000}, sent)
+}
+
+func TestWarp2LineVoltages(t *testing.T) {
+	ids := `[1,2,3,13,17,21,4,5,6]`
+	values := `[3,2,1,10,10,10,400,401,402]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		valueIdsG:     getter(&ids),
+		meterDetailsG: getter(&values),
+	}
+
+	u1, u2, u3, err := wb.voltages()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 2, 1}, []float64{u1, u2, u3})
+
+	wb.lineVoltages = true
+
+	u1, u2, u3, err = wb.voltages()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{400, 401, 402}, []float64{u1, u2, u3})
+
+	// value ids not published by meter
+	ids = `[1,2,3,13,17,21]`
+	_, _, _, err = wb.voltages()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+}
+
+func TestWarp2VoltageDeviation(t *testing.T) {
+	ids := `[1,2,3,13,17,21,4,5,6]`
+	values := `[230,207,0,10,10,10,398.4,398.4,398.4]`
+
+	wb := &Warp2{
+		log:            util.NewLogger("foo"),
+		valueIdsG:      getter(&ids),
+		meterDetailsG:  getter(&values),
+		voltageSag:     5,
+		nominalVoltage: 230,
+	}
+
+	// phase voltages not available
+	_, _, _, err := wb.VoltageDeviation()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	wb.meterVoltages = true
+
+	d1, d2, d3, err := wb.VoltageDeviation()
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0, -10, -100}, []float64{d1, d2, d3}, 1e-9)
+	assert.True(t, wb.checks.voltageSagged)
+
+	// disconnected phase is not flagged
+	values = `[230,225,0,10,10,10,398.4,398.4,398.4]`
+	_, _, _, err = wb.voltages()
+	require.NoError(t, err)
+	assert.False(t, wb.checks.voltageSagged)
+
+	// line-to-line voltages are compared against the line nominal
+	wb.lineVoltages = true
+
+	d1, _, _, err = wb.VoltageDeviation()
+	require.NoError(t, err)
+	assert.InDelta(t, 0, d1, 0.01)
+}
+
+func TestWarp2MonitorPayload(t *testing.T) {
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+	}
+
+	// not yet controlled by a loadpoint
+	res := wb.monitorPayload()
+	assert.Empty(t, res.Version)
+	assert.Empty(t, res.Mode)
+	assert.Zero(t, res.SetpointTime)
+	assert.NotZero(t, res.Timestamp)
+
+	ctrl := gomock.NewController(t)
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().GetMode().Return(api.ModePV)
+
+	updated := time.Unix(1700000000, 0)
+	wb.lp = lp
+	wb.requested = api.Setpoint{Current: 16, Updated: updated}
+	wb.SetVersion("0.300.0")
+
+	res = wb.monitorPayload()
+	assert.Equal(t, "0.300.0", res.Version)
+	assert.Equal(t, "pv", res.Mode)
+	assert.Equal(t, 16.0, res.Current)
+	assert.Equal(t, updated.Unix(), res.SetpointTime)
+}
+
+func TestWarp2UpdateSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lp := loadpoint.NewMockAPI(ctrl)
+
+	var sent []int64
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		display: display{
+			socS: func(soc int64) error {
+				sent = append(sent, soc)
+				return nil
+			},
+		},
+	}
+
+	lp.EXPECT().GetStatus().Return(api.StatusC).AnyTimes()
+	lp.EXPECT().GetSoc().Return(55.4).Times(2)
+	wb.updateSoc(lp)
+	wb.updateSoc(lp)
+
+	// soc unknown
+	lp.EXPECT().GetSoc().Return(0.0)
+	wb.updateSoc(lp)
+
+	assert.Equal(t, []int64{55, warp.SocNone}, sent)
+}
+
+func TestWarp2DisplaySoc(t *testing.T) {
+	var sent []int64
+
+	soc := int64(55)
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		display: display{
+			soc: &soc,
+			socS: func(soc int64) error {
+				sent = append(sent, soc)
+				return nil
+			},
+		},
+	}
+
+	// cleared on shutdown
+	done := make(chan struct{})
+	close(done)
+	wb.displaySoc(nil, done)
+
+	assert.Equal(t, []int64{warp.SocNone}, sent)
+}
+
+func TestWarp2PhasePower(t *testing.T) {
+	values := `[230,230,230,10,10,10,2300,2310,2290]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		meterDetailsG: getter(&values),
+	}
+
+	res, err := wb.phasePower()
+	require.NoError(t, err)
+	assert.Equal(t, 6900.0, res)
+}
+
+func TestWarp2PowerSign(t *testing.T) {
+	for _, tc := range []struct {
+		values, all string
+		invert      bool
+		power       float64
+		phases      []float64
+		current     float64
+	}{
+		// positive is import, negative export
+		{`{"power":2300}`, `[230,230,230,10,0,0,2300,0,0]`, false, 2300, []float64{2300, 0, 0}, 10},
+		{`{"power":-2300}`, `[230,230,230,10,0,0,-2300,0,0]`, false, -2300, []float64{-2300, 0, 0}, -10},
+		// inverted for meters reporting positive export
+		{`{"power":2300}`, `[230,230,230,10,0,0,2300,0,0]`, true, -2300, []float64{-2300, 0, 0}, -10},
+		{`{"power":-2300}`, `[230,230,230,10,0,0,-2300,0,0]`, true, 2300, []float64{2300, 0, 0}, 10},
+	} {
+		wb := &Warp2{
+			log:            util.NewLogger("foo"),
+			meterG:         getter(&tc.values),
+			meterDetailsG:  getter(&tc.all),
+			invertPower:    tc.invert,
+			signedCurrents: true,
+		}
+
+		power, err := wb.currentPower()
+		require.NoError(t, err)
+		assert.Equal(t, tc.power, power, tc)
+
+		power, err = wb.phasePower()
+		require.NoError(t, err)
+		assert.Equal(t, tc.power, power, tc)
+
+		res, err := wb.meterValues()
+		require.NoError(t, err)
+		assert.Equal(t, tc.phases, res[6:9], tc)
+
+		// signed currents follow the normalized power
+		l1, _, _, err := wb.currents()
+		require.NoError(t, err)
+		assert.Equal(t, tc.current, l1, tc)
+
+		// warp3 phase meter with the same sample
+		var sample warp.MeterValues
+		require.NoError(t, json.Unmarshal([]byte(tc.values), &sample))
+		phases := fmt.Sprintf(`[{"phase":1,"voltage":230,"current":10,"power":%g},{"phase":2,"voltage":230,"current":0,"power":0},{"phase":3,"voltage":230,"current":0,"power":0}]`, sample.Power)
+		wb.phasesG = getter(&phases)
+
+		power, err = wb.phasePower()
+		require.NoError(t, err)
+		assert.Equal(t, tc.power, power, tc)
+	}
+
+	_, err := NewWarp2FromConfig(map[string]any{"powersign": "foo"})
+	assert.ErrorContains(t, err, "invalid power sign")
+}
+
+func TestWarp2VehicleCurrent(t *testing.T) {
+	state := `{"soc":50}`
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		iso15118G: getter(&state),
+		iso15118:  true,
+	}
+
+	// firmware doesn't provide negotiated current
+	_, err := wb.vehicleCurrent()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	state = `{"soc":50,"ev_max_current":13.5}`
+	res, err := wb.vehicleCurrent()
+	require.NoError(t, err)
+	assert.Equal(t, 13.5, res)
+}
+
+func TestWarp2HoldPhases(t *testing.T) {
+	state := `{"iec61851_state":2}`
+	lowLevel := `{}`
+	em := `{"external_control":0,"phases_switched":3}`
+
+	var sent []int64
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		statusG:    getter(&state),
+		lowLevelG:  getter(&lowLevel),
+		emStateG:   getter(&em),
+		chargeG:    unavailable,
+		holdPhases: true,
+		phasesS: func(phases int64) error {
+			sent = append(sent, phases)
+			return nil
+		},
+	}
+
+	// deferred while charging
+	require.ErrorIs(t, wb.phases1p3p(1), api.ErrMustRetry)
+	assert.Empty(t, sent)
+
+	_, err := wb.Status()
+	require.NoError(t, err)
+	assert.Empty(t, sent)
+
+	// applied when retried once charging stops
+	state = `{"iec61851_state":1}`
+
+	_, err = wb.Status()
+	require.NoError(t, err)
+	assert.Empty(t, sent)
+
+	require.NoError(t, wb.phases1p3p(1))
+	assert.Equal(t, []int64{1}, sent)
+}
+
+func TestWarp2RegisterMeter(t *testing.T) {
+	wb := &Warp2{log: util.NewLogger("foo")}
+
+	// box without meter
+	require.Error(t, wb.registerMeter("warp-test-meter", nil, nil, nil, nil))
+
+	power := func() (float64, error) { return 4200, nil }
+	require.NoError(t, wb.registerMeter("warp-test-meter", power, nil, nil, nil))
+	defer func() { _ = config.Meters().Delete("warp-test-meter") }()
+
+	dev, err := config.Meters().ByName("warp-test-meter")
+	require.NoError(t, err)
+
+	res, err := dev.Instance().CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, 4200.0, res)
+
+	// names are unique
+	require.Error(t, wb.registerMeter("warp-test-meter", power, nil, nil, nil))
+}
+
+func TestWarp2Finite(t *testing.T) {
+	values := `[230,230,230,10,10,10]`
+
+	wb := &Warp2{log: util.NewLogger("foo")}
+	g := wb.finite(getter(&values))
+
+	res, err := g()
+	require.NoError(t, err)
+	assert.Equal(t, values, res)
+
+	// last good value
+	good := values
+	values = `[230,NaN,230,10,null,10]`
+	res, err = g()
+	require.NoError(t, err)
+	assert.Equal(t, good, res)
+
+	// no good value yet
+	_, err = wb.finite(getter(&values))()
+	assert.ErrorIs(t, err, warp.ErrNonFinite)
+}
+
+func TestWarp2SinglePhase(t *testing.T) {
+	em := `{"external_control":0,"phases_switched":3}`
+
+	var sent []int64
+
+	wb := &Warp2{
+		log:            util.NewLogger("foo"),
+		emStateG:       getter(&em),
+		phaseSwitching: true,
+		singlePhase:    true,
+		phasesS: func(phases int64) error {
+			sent = append(sent, phases)
+			return nil
+		},
+	}
+
+	assert.Error(t, wb.phases1p3p(3))
+	require.NoError(t, wb.phases1p3p(1))
+	assert.Equal(t, []int64{1}, sent)
+
+	res, err := wb.getPhases()
+	require.NoError(t, err)
+	assert.Equal(t, 1, res)
+}
+
+func TestWarp2CrossCheck(t *testing.T) {
+	wb := &Warp2{
+		log:              util.NewLogger("foo"),
+		currentTolerance: 2,
+		lastStatus:       api.StatusC,
+		published:        publishedCurrent{Current: 10000, Updated: time.Now()},
+	}
+
+	wb.crossCheck(11)
+	assert.False(t, wb.checks.currentMismatch)
+
+	wb.crossCheck(16)
+	assert.True(t, wb.checks.currentMismatch)
+
+	// not charging
+	wb.lastStatus = api.StatusB
+	wb.crossCheck(16)
+	assert.False(t, wb.checks.currentMismatch)
+}
+
+func TestWarp2Energies(t *testing.T) {
+	ids := `[1,2,3,210,211,212]`
+	values := `[230,230,230,100.5,90,80]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		valueIdsG:     getter(&ids),
+		meterDetailsG: getter(&values),
+	}
+
+	e1, e2, e3, err := wb.energies()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{100.5, 90, 80}, []float64{e1, e2, e3})
+
+	// meter without per-phase energy
+	ids = `[1,2,3,13,17,21]`
+	_, _, _, err = wb.energies()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+}
+
+func TestWarp2Keepalive(t *testing.T) {
+	var sent []int64
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		pings: pinger{
+			set: func(id int64) error {
+				sent = append(sent, id)
+				return nil
+			},
+		},
+	}
+
+	wb.ping(1)
+	assert.False(t, wb.stalled.Load())
+
+	// echoed
+	wb.pings.echoed.Store(1)
+	wb.ping(2)
+	assert.False(t, wb.stalled.Load())
+
+	// not echoed
+	wb.ping(3)
+	assert.True(t, wb.stalled.Load())
+
+	_, err := wb.status()
+	assert.ErrorIs(t, err, api.ErrTimeout)
+
+	wb.pings.echoed.Store(3)
+	wb.ping(4)
+	assert.False(t, wb.stalled.Load())
+
+	assert.Equal(t, []int64{1, 2, 3, 4}, sent)
+}
+
+func TestWarp2MeterMissing(t *testing.T) {
+	state := `{"iec61851_state":2}`
+
+	wb := &Warp2{
+		log:            util.NewLogger("foo"),
+		statusG:        getter(&state),
+		lowLevelG:      unavailable,
+		chargeG:        unavailable,
+		confirmed:      api.Setpoint{Current: 10},
+		nominalVoltage: 220,
+		meterWatch: meterWatch{meterMissingSettings: meterMissingSettings{
+			Timeout: time.Millisecond,
+			Demote:  true,
+		}},
+	}
+
+	var power float64
+	var err error = api.ErrTimeout
+
+	currentPower := wb.watchMeter(func() (float64, error) {
+		return power, err
+	})
+
+	// outage shorter than timeout
+	_, e := currentPower()
+	assert.ErrorIs(t, e, api.ErrTimeout)
+
+	time.Sleep(2 * time.Millisecond)
+
+	// demoted to estimated power
+	res, e := currentPower()
+	require.NoError(t, e)
+	assert.Equal(t, 3*10*220.0, res)
+
+	state = `{"iec61851_state":1}`
+	res, e = currentPower()
+	require.NoError(t, e)
+	assert.Zero(t, res)
+
+	// data resumed
+	power, err = 1e3, nil
+	res, e = currentPower()
+	require.NoError(t, e)
+	assert.Equal(t, 1e3, res)
+	assert.False(t, wb.meterWatch.missing)
+
+	// warn only
+	wb.meterWatch.Demote = false
+	err = api.ErrTimeout
+
+	_, _ = currentPower()
+	time.Sleep(2 * time.Millisecond)
+	_, e = currentPower()
+	assert.ErrorIs(t, e, api.ErrTimeout)
+	assert.True(t, wb.meterWatch.missing)
+}
+
+func TestWarp2RefreshTags(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	wb := &Warp2{
+		log:    util.NewLogger("foo"),
+		writer: client,
+		root:   "warp",
+	}
+
+	assert.ErrorIs(t, wb.refreshTags(0, 0), api.ErrNotAvailable)
+
+	wb.nfc = true
+	assert.ErrorIs(t, wb.refreshTags(0, 0), api.ErrTimeout)
+
+	const tags = `{"authorized_tags":[{"user_id":1,"tag_type":2,"tag_id":"04:AB"}],"deadtime_post_start":30}`
+	wb.updateNfcConfig(tags)
+
+	// not reloaded
+	assert.ErrorContains(t, wb.refreshTags(20*time.Millisecond, time.Millisecond), "not confirmed")
+
+	reload := func(payload string) {
+		time.Sleep(5 * time.Millisecond)
+		wb.updateNfcConfig(payload)
+	}
+
+	go reload(tags)
+	assert.NoError(t, wb.refreshTags(time.Second, time.Millisecond))
+
+	go reload(`{"authorized_tags":[]}`)
+	assert.ErrorContains(t, wb.refreshTags(time.Second, time.Millisecond), "changed")
+}
+
+func TestWarp2Transitions(t *testing.T) {
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+	}
+
+	for _, payload := range []string{
+		`{"iec61851_state":1}`,
+		`{"iec61851_state":1}`, // retained and live message
+		`{"iec61851_state":2}`,
+		`{"iec61851_state":1}`,
+		`{"iec61851_state":9}`,
+		`{"iec61851_state":0}`,
+	} {
+		wb.trackStatus(payload)
+	}
+
+	assert.Equal(t, []api.ChargeStatus{api.StatusB, api.StatusC, api.StatusB, api.StatusA}, wb.Transitions())
+	assert.Empty(t, wb.Transitions())
+
+	// unchanged after read
+	wb.trackStatus(`{"iec61851_state":0}`)
+	assert.Empty(t, wb.Transitions())
+
+	for i := 0; i < 2*warp.TransitionBuffer; i++ {
+		wb.trackStatus(fmt.Sprintf(`{"iec61851_state":%d}`, i%2))
+	}
+	res := wb.Transitions()
+	assert.Len(t, res, warp.TransitionBuffer)
+	assert.Equal(t, api.StatusB, res[len(res)-1])
+}
+
+// rejected is a string provider refused by the broker
+type rejected struct{}
+
+func (rejected) StringGetter() (func() (string, error), error) {
+	return nil, fmt.Errorf("subscribe: %w", mqtt.ErrRejected)
+}
+
+func TestWarp2PollFallback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/evse/state" {
+			_, _ = w.Write([]byte(`{"iec61851_state":1}`))
+		}
+	}))
+	defer srv.Close()
+
+	wb := &Warp2{
+		log:  util.NewLogger("foo"),
+		root: "warp",
+	}
+
+	// no host
+	_, err := wb.pollFallback("warp/evse/state", rejected{}).StringGetter()
+	assert.ErrorIs(t, err, mqtt.ErrRejected)
+
+	wb.host = srv.URL
+
+	// energy manager topic
+	_, err = wb.pollFallback("em/energy_manager/state", rejected{}).StringGetter()
+	assert.ErrorIs(t, err, mqtt.ErrRejected)
+
+	g, err := wb.pollFallback("warp/evse/state", rejected{}).StringGetter()
+	require.NoError(t, err)
+
+	s, err := g()
+	require.NoError(t, err)
+	assert.Equal(t, `{"iec61851_state":1}`, s)
+}
+
+func TestWarp2OfferedCurrent(t *testing.T) {
+	lowLevel := `{"uptime":1}`
+	current := `{"current":16000}`
+
+	wb := &Warp2{
+		log:         util.NewLogger("foo"),
+		lowLevelG:   getter(&lowLevel),
+		maxcurrentG: getter(&current),
+		slotsG:      unavailable,
+	}
+
+	// not published by firmware
+	_, err := wb.OfferedCurrent()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	lowLevel = `{"cp_pwm_duty_cycle":266}`
+	res, err := wb.OfferedCurrent()
+	require.NoError(t, err)
+	assert.InDelta(t, 16, res, 0.1)
+	assert.True(t, wb.checks.offeredSince.IsZero())
+
+	// mismatch within grace period
+	current = `{"current":10000}`
+	_, _ = wb.OfferedCurrent()
+	assert.False(t, wb.checks.offeredSince.IsZero())
+	assert.False(t, wb.checks.offeredMismatch)
+
+	wb.checks.offeredSince = time.Now().Add(-warp.OfferedGrace)
+	_, _ = wb.OfferedCurrent()
+	assert.True(t, wb.checks.offeredMismatch)
+
+	// no pwm without vehicle
+	wb.checkDutyCycle(`{"cp_pwm_duty_cycle":1000}`)
+	assert.False(t, wb.checks.offeredMismatch)
+	assert.True(t, wb.checks.offeredSince.IsZero())
+}
+
+func TestWarp2Reboot(t *testing.T) {
+	var currents, phases []int64
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		maxcurrentS: func(current int64) error {
+			currents = append(currents, current)
+			return nil
+		},
+		phasesS: func(p int64) error {
+			phases = append(phases, p)
+			return nil
+		},
+		phaseSwitching: true,
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	// nothing to re-assert
+	wb.checkUptime(`{"uptime":50000}`)
+	wb.checkUptime(`{"uptime":1000}`)
+	assert.Empty(t, currents)
+
+	require.NoError(t, wb.setCurrent(10000, false))
+	wb.phaseCommand.confirmed = 1
+
+	wb.checkUptime(`{"uptime":60000}`)
+	assert.Equal(t, []int64{10000}, currents)
+
+	// rebooted
+	wb.checkUptime(`{"uptime":2000}`)
+	assert.Equal(t, []int64{10000, 10000}, currents)
+	assert.Equal(t, []int64{1}, phases)
+}
+
+func TestWarp2AdaptiveTimeout(t *testing.T) {
+	lowLevel := `{}`
+
+	wb := &Warp2{
+		log:       util.NewLogger("foo"),
+		lowLevelG: getter(&lowLevel),
+		timeout:   time.Minute,
+	}
+
+	_, err := wb.ticker()
+	require.NoError(t, err)
+
+	wb.cadence = new(warp.Cadence)
+	ts := time.Now().Add(-time.Minute)
+	for i := 0; i <= warp.CadenceSamples; i++ {
+		wb.cadence.Update(ts)
+		ts = ts.Add(time.Second)
+	}
+
+	// last update 55s ago, within manual timeout but beyond observed cadence
+	_, err = wb.ticker()
+	assert.ErrorIs(t, err, api.ErrOutdated)
+
+	wb.cadence.Update(time.Now())
+	_, err = wb.ticker()
+	assert.NoError(t, err)
+}
+
+func TestWarp2Price(t *testing.T) {
+	var sent []warp.Price
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		display: display{
+			priceS: func(p warp.Price) error {
+				sent = append(sent, p)
+				return nil
+			},
+		},
+	}
+
+	require.NoError(t, wb.setPrice(0.30123, "EUR"))
+	require.NoError(t, wb.setPrice(0.301234, "EUR")) // unchanged at box resolution
+	require.NoError(t, wb.setPrice(30, "JPY"))
+
+	assert.Equal(t, []warp.Price{
+		{Price: 30123, Currency: "EUR"},
+		{Price: 30000, Currency: "JPY"},
+	}, sent)
+}
+
+func TestWarp2ClearError(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		state  = `{"iec61851_state":4,"error_state":5}`
+		resets int
+		clears bool
+	)
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		statusG: func() (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			return state, nil
+		},
+		resetS: func() error {
+			mu.Lock()
+			defer mu.Unlock()
+			resets++
+			if clears {
+				state = `{"iec61851_state":1,"error_state":0}`
+			}
+			return nil
+		},
+	}
+
+	// persistent error
+	assert.Error(t, wb.clearError(10*time.Millisecond, time.Millisecond))
+	assert.Equal(t, 1, resets)
+
+	// automatic resets are bounded
+	wb.errorClear = errorClear{
+		errorClearSettings: errorClearSettings{Auto: true, Attempts: 2, Backoff: time.Millisecond},
+		timeout:            10 * time.Millisecond,
+		interval:           time.Millisecond,
+	}
+
+	exhausted := func() bool {
+		wb.errorClear.mu.Lock()
+		defer wb.errorClear.mu.Unlock()
+		return wb.errorClear.exhausted
+	}
+
+	wb.autoClear(state)
+	require.Eventually(t, exhausted, time.Second, time.Millisecond)
+
+	wb.autoClear(state)
+	mu.Lock()
+	assert.Equal(t, 3, resets)
+	clears = true
+	mu.Unlock()
+
+	// error cleared otherwise, next fault is reset again
+	wb.autoClear(`{"iec61851_state":1,"error_state":0}`)
+	assert.False(t, exhausted())
+
+	wb.autoClear(`{"iec61851_state":4,"error_state":5}`)
+	require.Eventually(t, func() bool {
+		s, _ := wb.evseError()
+		return s == warp.ErrorStateOk
+	}, time.Second, time.Millisecond)
+
+	assert.Equal(t, 4, resets)
+	assert.NoError(t, wb.ClearError())
+	assert.Equal(t, 4, resets)
+}
+
+func TestWarp2DisableHold(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		currents []int64
+	)
+
+	published := func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(currents)
+	}
+
+	wb := &Warp2{
+		log: util.NewLogger("foo"),
+		maxcurrentS: func(current int64) error {
+			mu.Lock()
+			defer mu.Unlock()
+			currents = append(currents, current)
+			return nil
+		},
+		autoStartG:  unavailable,
+		slotsG:      unavailable,
+		mincurrentG: unavailable,
+		resolution:  1,
+		current:     16000,
+		hold: disableHold{
+			disableHoldSettings: disableHoldSettings{Hold: 20 * time.Millisecond, Ramp: true},
+		},
+	}
+	wb.maxcurrentS = wb.trackCurrent(wb.maxcurrentS)
+
+	require.NoError(t, wb.Enable(true))
+	require.NoError(t, wb.Enable(false))
+
+	// ramped down, reported disabled while holding
+	assert.Equal(t, []int64{16000, warp.MinCurrent}, published())
+	enabled, err := wb.Enabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	// current changes are applied when enabling
+	require.NoError(t, wb.MaxCurrentMillis(10))
+	assert.Equal(t, []int64{16000, warp.MinCurrent}, published())
+
+	require.Eventually(t, func() bool {
+		return !wb.holding()
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{16000, warp.MinCurrent, 0}, published())
+
+	// already disabled
+	require.NoError(t, wb.Enable(false))
+	assert.Equal(t, []int64{16000, warp.MinCurrent, 0, 0}, published())
+
+	// enabling cancels the pending disable
+	require.NoError(t, wb.Enable(true))
+	require.NoError(t, wb.Enable(false))
+	require.NoError(t, wb.Enable(true))
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, []int64{16000, warp.MinCurrent, 0, 0, 10000, warp.MinCurrent, 10000}, published())
+}
+
+func TestWarp2FirmwareVersion(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/info/version","payload":{"firmware":"2.4.0-65a0b2c5","config":"2.0.0"}}
+`), 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	wb := &Warp2{
+		log:     util.NewLogger("foo"),
+		client:  client,
+		root:    "missing",
+		timeout: 10 * time.Millisecond,
+	}
+
+	// not published
+	_, err = wb.firmwareVersion()
+	assert.Error(t, err)
+	assert.Nil(t, wb.firmware)
+
+	wb.root = "warp"
+	wb.timeout = time.Second
+
+	res, err := wb.firmwareVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "2.4.0-65a0b2c5", res.String())
+
+	// cached
+	wb.client = nil
+	res, err = wb.firmwareVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "2.0.0", res.Config)
+}
+
+func TestWarp2ConfigGetter(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/event_log","payload":"2024-01-01 00:00:00,000  started"}
+`), 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	wb := &Warp2{
+		log:     util.NewLogger("foo"),
+		client:  client,
+		root:    "warp",
+		timeout: time.Second,
+	}
+
+	_, err = wb.eventLog()
+	require.NoError(t, err)
+
+	// subscription reused
+	wb.client = nil
+	_, err = wb.eventLog()
+	require.NoError(t, err)
+}
+
+func TestWarp2FeatureCache(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/info/features","payload":["evse","meter"]}
+`), 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	wb := &Warp2{
+		log:          util.NewLogger("foo"),
+		client:       client,
+		featureCache: true,
+	}
+
+	key := wb.featureKey("warp")
+	require.NoError(t, settings.SetJson(key, []string{"evse", "nfc"}))
+
+	// cached features are used without waiting
+	assert.True(t, wb.hasFeature("warp", warp.FeatureNfc, time.Second))
+	assert.False(t, wb.hasFeature("warp", warp.FeatureMeter, time.Second))
+
+	// refreshed in background
+	require.Eventually(t, func() bool {
+		var res []string
+		return settings.Json(key, &res) == nil && warp.SameFeatures(res, []string{"meter", "evse"})
+	}, time.Second, time.Millisecond)
+}
+
+func TestWarp2EvsePhaseSwitching(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/low_level_state","payload":{}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/features","payload":["evse","phase_switch"]}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/name","payload":{"display_type":"WARP2 Charger Smart"}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/external_control","payload":{"external_control":0,"phases_switched":3}}
+`), 0o644))
+	t.Setenv("EVCC_WARP_REPLAY", file)
+
+	_, err := NewWarp2FromConfig(map[string]any{"energymanager": "warp-em", "features": map[string]bool{warp.FeaturePhaseSwitch: true}})
+	assert.ErrorContains(t, err, "not both")
+
+	c, err := NewWarp2FromConfig(map[string]any{})
+	require.NoError(t, err)
+
+	_, ok := c.(api.PhaseSwitcher)
+	assert.True(t, ok)
+
+	_, ok = c.(api.PhaseGetter)
+	assert.True(t, ok)
+}
+
+func TestWarp2StartupWithoutFeatures(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/low_level_state","payload":{}}
+`), 0o644))
+	t.Setenv("EVCC_WARP_REPLAY", file)
+
+	// features and external control are waited for once at most
+	start := time.Now()
+	c, err := NewWarp2FromConfig(map[string]any{"timeout": "200ms", "features": map[string]bool{warp.FeaturePhaseSwitch: true}})
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 400*time.Millisecond)
+
+	_, ok := c.(api.PhaseSwitcher)
+	assert.True(t, ok)
+}
+
+func TestWarp2SmartEnergyManager(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/low_level_state","payload":{}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/features","payload":["evse"]}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/name","payload":{"display_type":"WARP2 Charger Smart"}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp-em/energy_manager/state","payload":{"external_control":0,"phases_switched":3}}
+`), 0o644))
+	t.Setenv("EVCC_WARP_REPLAY", file)
+
+	// energy manager contactor switches phases regardless of the box variant
+	c, err := NewWarp2FromConfig(map[string]any{"energymanager": "warp-em"})
+	require.NoError(t, err)
+
+	_, ok := c.(api.PhaseSwitcher)
+	assert.True(t, ok)
+
+	wb := c.(interface{ hardwareVariant() warp.Variant })
+	require.Eventually(t, func() bool {
+		return wb.hardwareVariant() == warp.VariantSmart
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestWarp2SmartMeter(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/low_level_state","payload":{}}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/features","payload":["evse","meter","meter_phases"]}
+{"time":"2024-01-01T00:00:00Z","topic":"warp/info/name","payload":{"display_type":"WARP2 Charger Smart"}}
+`), 0o644))
+	t.Setenv("EVCC_WARP_REPLAY", file)
+
+	// smart boxes have no meter even if the feature is advertised
+	c, err := NewWarp2FromConfig(map[string]any{"timeout": "200ms"})
+	require.NoError(t, err)
+
+	_, ok := c.(api.Meter)
+	assert.False(t, ok)
+
+	_, ok = c.(api.PhaseCurrents)
+	assert.False(t, ok)
+}
+
+func TestWarp2Temperature(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/temperature","payload":{"temperature":4250}}
+{"time":"2024-01-01T00:00:00Z","topic":"invalid/evse/temperature","payload":{"temperature":-27315}}
+`), 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		root string
+		res  float64
+		err  error
+	}{
+		{"warp", 42.5, nil},
+		{"invalid", 0, api.ErrNotAvailable},
+		{"missing", 0, api.ErrNotAvailable},
+	} {
+		wb := &Warp2{
+			log:    util.NewLogger("foo"),
+			client: client,
+			root:   tc.root,
+			topics: warp.NewTopics(util.NewLogger("foo"), client, nil, nil),
+		}
+
+		wb.detectTemperature(100 * time.Millisecond)
+
+		res, err := wb.Temperature()
+		assert.ErrorIs(t, err, tc.err, tc.root)
+		assert.Equal(t, tc.res, res, tc.root)
+	}
+}
+
+// broker is a paho client with switchable connection state
+type broker struct {
+	paho.Client
+	open atomic.Bool
+}
+
+func (b *broker) IsConnectionOpen() bool { return b.open.Load() }
+
+func TestWarp2BrokerConnection(t *testing.T) {
+	b := new(broker)
+	client := mqtt.NewAdapterClient(util.NewLogger("foo"), "mock", b)
+
+	state := `{"iec61851_state":1}`
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		client:     client,
+		statusG:    getter(&state),
+		chargeG:    unavailable,
+		lowLevelG:  unavailable,
+		resolution: 1,
+	}
+	client.OnConnectionChange(wb.connectionChanged)
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, status)
+
+	client.ConnectionLostHandler(b, errors.New("EOF"))
+
+	status, err = wb.Status()
+	assert.ErrorIs(t, err, api.ErrTimeout)
+	assert.ErrorContains(t, err, "disconnected")
+	assert.Equal(t, api.StatusNone, status)
+
+	b.open.Store(true)
+	client.ConnectionHandler(b)
+
+	status, err = wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, status)
+}
+
+// publisher is a paho client recording the retained flag of published topics
+type publisher struct {
+	paho.Client
+	mu       sync.Mutex
+	retained map[string]bool
+}
+
+func (p *publisher) IsConnectionOpen() bool { return true }
+
+func (p *publisher) Publish(topic string, _ byte, retained bool, _ interface{}) paho.Token {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.retained[topic] = retained
+	return new(paho.DummyToken)
+}
+
+func TestWarp2Retained(t *testing.T) {
+	p := &publisher{retained: make(map[string]bool)}
+	client := mqtt.NewAdapterClient(util.NewLogger("foo"), "mock", p)
+
+	wb := &Warp2{
+		log:      util.NewLogger("foo"),
+		root:     "warp",
+		retained: true,
+	}
+	require.NoError(t, wb.setters(client, "em"))
+
+	require.NoError(t, wb.maxcurrentS(6000))
+	require.NoError(t, wb.phasesS(1))
+	require.NoError(t, wb.externalEnabledS(true))
+	require.NoError(t, wb.resetS())
+
+	// commanded state only
+	assert.Equal(t, map[string]bool{
+		"warp/evse/external_current_update":         true,
+		"em/energy_manager/external_control_update": true,
+		"warp/evse/external_enabled_update":         false,
+		"warp/evse/reset":                           false,
+	}, p.retained)
+}
+
+func TestWarp2StaleGrace(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/state","payload":{"iec61851_state":2}}
+`), 0o644))
+
+	client, err := mqtt.NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	var tick atomic.Pointer[error]
+	tick.Store(new(error))
+
+	to := provider.NewTimeoutHandler(func() (string, error) {
+		return "", *tick.Load()
+	}).WithGrace(util.NewLogger("foo"), 100*time.Millisecond)
+
+	statusG, err := warp.NewTopics(util.NewLogger("foo"), client, to, nil).Getter("warp/evse/state")
+	require.NoError(t, err)
+
+	wb := &Warp2{
+		log:        util.NewLogger("foo"),
+		client:     client,
+		statusG:    statusG,
+		chargeG:    unavailable,
+		lowLevelG:  unavailable,
+		resolution: 1,
+	}
+
+	// fresh
+	require.Eventually(t, func() bool {
+		status, err := wb.Status()
+		return err == nil && status == api.StatusC
+	}, time.Second, 10*time.Millisecond)
+
+	// grace
+	outdated := api.ErrOutdated
+	tick.Store(&outdated)
+
+	status, err := wb.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	// hard fail
+	time.Sleep(100 * time.Millisecond)
+	_, err = wb.Status()
+	assert.ErrorIs(t, err, api.ErrOutdated)
+
+	_, err = NewWarp2FromConfig(map[string]any{"stalegrace": "-1s"})
+	assert.ErrorContains(t, err, "invalid stale grace")
+}
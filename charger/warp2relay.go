@@ -0,0 +1,118 @@
+package charger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/charger/warp"
+	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Warp2Relay switches the relay output of the WARP energy manager, e.g. for SG-Ready heat pumps
+type Warp2Relay struct {
+	*switchSocket
+	stateG func() (string, error)
+	relayS func(bool) error
+}
+
+func init() {
+	registry.Add("warp2-relay", NewWarp2RelayFromConfig)
+}
+
+// NewWarp2RelayFromConfig creates a new configurable charger
+func NewWarp2RelayFromConfig(other map[string]interface{}) (api.Charger, error) {
+	cc := struct {
+		embed         `mapstructure:",squash"`
+		mqtt.Config   `mapstructure:",squash"`
+		EnergyManager string
+		StandbyPower  float64 // negative static power, the relay output has no meter
+		Timeout       time.Duration
+	}{
+		Timeout: warp.Timeout,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	if cc.EnergyManager == "" {
+		return nil, errors.New("missing energy manager topic")
+	}
+
+	if cc.StandbyPower >= 0 {
+		return nil, errors.New("standbypower must be negative static power")
+	}
+
+	return NewWarp2Relay(&cc.embed, cc.Config, cc.EnergyManager, cc.StandbyPower, cc.Timeout)
+}
+
+// NewWarp2Relay creates a new configurable charger
+func NewWarp2Relay(embed *embed, mqttconf mqtt.Config, emTopic string, standbypower float64, timeout time.Duration) (*Warp2Relay, error) {
+	log := util.NewLogger("warp-relay")
+
+	client, err := mqtt.RegisteredClientOrDefault(log, mqttconf)
+	if err != nil {
+		return nil, err
+	}
+
+	// relay control requires energy manager firmware
+	featuresG, err := provider.NewMqtt(log, client, fmt.Sprintf("%s/info/features", emTopic), timeout).StringGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	var features []string
+	if s, err := featuresG(); err == nil {
+		err = json.Unmarshal([]byte(s), &features)
+	}
+	if !slices.Contains(features, warp.FeatureEnergyManager) {
+		return nil, fmt.Errorf("relay not supported by %s", emTopic)
+	}
+
+	c := new(Warp2Relay)
+
+	c.stateG, err = provider.NewMqtt(log, client, fmt.Sprintf("%s/energy_manager/state", emTopic), timeout).StringGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	c.relayS, err = provider.NewMqtt(log, client,
+		fmt.Sprintf("%s/energy_manager/relay_update", emTopic), 0).
+		WithPayload(`{ "state": ${enable} }`).
+		BoolSetter("enable")
+	if err != nil {
+		return nil, err
+	}
+
+	c.switchSocket = NewSwitchSocket(embed, c.Enabled, c.currentPower, standbypower)
+
+	return c, nil
+}
+
+// Enabled implements the api.Charger interface
+func (c *Warp2Relay) Enabled() (bool, error) {
+	var res warp.EmState
+
+	s, err := c.stateG()
+	if err == nil {
+		err = json.Unmarshal([]byte(s), &res)
+	}
+
+	return res.RelayState, err
+}
+
+// Enable implements the api.Charger interface
+func (c *Warp2Relay) Enable(enable bool) error {
+	return c.relayS(enable)
+}
+
+// currentPower is not available, power is static
+func (c *Warp2Relay) currentPower() (float64, error) {
+	return 0, api.ErrNotAvailable
+}
@@ -0,0 +1,40 @@
+package charger
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarp2Relay(t *testing.T) {
+	state := `{"relay_state":false}`
+
+	var relay bool
+	c := &Warp2Relay{
+		stateG: getter(&state),
+		relayS: func(enable bool) error {
+			relay = enable
+			return nil
+		},
+	}
+	c.switchSocket = NewSwitchSocket(new(embed), c.Enabled, c.currentPower, -2000)
+
+	status, err := c.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusB, status)
+
+	require.NoError(t, c.Enable(true))
+	assert.True(t, relay)
+
+	state = `{"relay_state":true}`
+
+	status, err = c.Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	power, err := c.CurrentPower()
+	require.NoError(t, err)
+	assert.Equal(t, 2000.0, power)
+}
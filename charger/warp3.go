@@ -0,0 +1,29 @@
+package charger
+
+import (
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/charger/warp"
+	"github.com/evcc-io/evcc/provider/mqtt"
+)
+
+func init() {
+	registry.Add("warp3", NewWarp3FromConfig)
+}
+
+// NewWarp3FromConfig creates a new configurable charger. WARP3 boxes share the warp2 topics and configuration
+// except for the phase meter values, which are read from the phases topic.
+func NewWarp3FromConfig(other map[string]interface{}) (api.Charger, error) {
+	return newWarp2FromConfig(other, true)
+}
+
+// NewWarp3 creates a new configurable charger
+func NewWarp3(mqttconf mqtt.Config, topic, emTopic string, timeout time.Duration) (*Warp2, error) {
+	wb, err := newWarp2(nil, mqttconf, topic, emTopic, "", timeout, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return wb, wb.phaseMeterGetter(warp.MeterTopic(0))
+}
@@ -0,0 +1,39 @@
+package charger
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarp3PhaseMeter(t *testing.T) {
+	// all values positions differ from warp2 and must not be used
+	values := `[0,0,0,0,0,0,0,0,0]`
+	phases := `[{"phase":2,"voltage":231,"current":8,"power":-1840},{"phase":1,"voltage":230,"current":10,"power":2300},{"phase":3,"voltage":232,"current":0,"power":0}]`
+
+	wb := &Warp2{
+		log:           util.NewLogger("foo"),
+		meterDetailsG: getter(&values),
+		phasesG:       getter(&phases),
+	}
+
+	l1, l2, l3, err := wb.currents()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, 8, 0}, []float64{l1, l2, l3})
+
+	u1, u2, u3, err := wb.voltages()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{230, 231, 232}, []float64{u1, u2, u3})
+
+	power, err := wb.phasePower()
+	require.NoError(t, err)
+	assert.Equal(t, 460.0, power)
+
+	wb.signedCurrents = true
+
+	l1, l2, l3, err = wb.currents()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{10, -8, 0}, []float64{l1, l2, l3})
+}
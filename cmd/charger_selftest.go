@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/spf13/cobra"
+)
+
+// chargerSelftestCmd represents the charger selftest command
+var chargerSelftestCmd = &cobra.Command{
+	Use:   "selftest [name]",
+	Short: "Validate reading and controlling the charger by briefly enabling at minimum current",
+	Args:  cobra.MaximumNArgs(1),
+	Run:   runChargerSelftest,
+}
+
+func init() {
+	chargerCmd.AddCommand(chargerSelftestCmd)
+
+	chargerSelftestCmd.Flags().StringP(flagDelay, "", "10s", "delay before verifying the charger follows commands")
+}
+
+// selftestStep prints the result of a self test step and returns true if passed
+func selftestStep(name string, res any, err error) bool {
+	if err != nil {
+		fmt.Printf("FAIL\t%s:\t%v\n", name, err)
+		return false
+	}
+
+	fmt.Printf("PASS\t%s:\t%v\n", name, res)
+	return true
+}
+
+func selftest(name string, c api.Charger, delay time.Duration) bool {
+	fmt.Printf("\n%s\n", name)
+
+	status, err := c.Status()
+	ok := selftestStep("status", status, err)
+
+	enabled, err := c.Enabled()
+	ok = selftestStep("enabled", enabled, err) && ok
+
+	if m, isMeter := c.(api.Meter); isMeter {
+		power, err := m.CurrentPower()
+		ok = selftestStep("power", fmt.Sprintf("%.0fW", power), err) && ok
+	}
+
+	if !ok {
+		fmt.Println("skipping control test after read failures")
+		return false
+	}
+
+	// charger's minimum current if reported
+	current := 6.0
+	if cl, isLimiter := c.(api.CurrentLimiter); isLimiter {
+		if minCurrent, _, err := cl.GetMinMaxCurrent(); err == nil && minCurrent > 0 {
+			current = minCurrent
+		}
+	}
+
+	// never energize a vehicle without confirmation
+	var confirmed bool
+	if err := survey.AskOne(&survey.Confirm{
+		Message: fmt.Sprintf("Enable %s at %.3gA for %v? A connected vehicle may start charging.", name, current, delay),
+	}, &confirmed); err != nil || !confirmed {
+		fmt.Println("control test skipped")
+		return ok
+	}
+
+	if cx, isEx := c.(api.ChargerEx); isEx {
+		err = cx.MaxCurrentMillis(current)
+	} else {
+		err = c.MaxCurrent(int64(math.Ceil(current)))
+	}
+	if err == nil {
+		err = c.Enable(true)
+	}
+	ok = selftestStep("enable", fmt.Sprintf("%.3gA", current), err)
+
+	if ok {
+		time.Sleep(delay)
+
+		enabled, err := c.Enabled()
+		if err == nil && !enabled {
+			err = fmt.Errorf("charger not enabled")
+		}
+		ok = selftestStep("enabled", enabled, err)
+
+		status, err := c.Status()
+		ok = selftestStep("status", status, err) && ok
+	}
+
+	// always try to disable again
+	err = c.Enable(false)
+	if err == nil {
+		time.Sleep(delay)
+
+		if enabled, err = c.Enabled(); err == nil && enabled {
+			err = fmt.Errorf("charger still enabled")
+		}
+	}
+
+	return selftestStep("disable", enabled, err) && ok
+}
+
+func runChargerSelftest(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, conf); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	if err := configureChargers(conf.Chargers, args...); err != nil {
+		log.FATAL.Fatal(err)
+	}
+
+	delay, err := time.ParseDuration(cmd.Flags().Lookup(flagDelay).Value.String())
+	if err != nil {
+		log.ERROR.Fatalln(err)
+	}
+
+	passed := true
+	for _, dev := range config.Chargers().Devices() {
+		passed = selftest(dev.Config().Name, dev.Instance(), delay) && passed
+	}
+
+	if passed {
+		fmt.Println("\nself test passed")
+	} else {
+		fmt.Println("\nself test failed")
+	}
+
+	// wait for shutdown
+	<-shutdownDoneC()
+}
@@ -78,6 +78,14 @@ func (d *dumper) Dump(name string, v interface{}) {
 		}
 	}
 
+	if v, ok := v.(api.PhaseEnergies); ok {
+		if e1, e2, e3, err := v.Energies(); err == nil {
+			fmt.Fprintf(w, "Energy L1..L3:\t%.1fkWh %.1fkWh %.1fkWh\n", e1, e2, e3)
+		} else if !errors.Is(err, api.ErrNotAvailable) {
+			fmt.Fprintf(w, "Energy L1..L3:\t%v\n", err)
+		}
+	}
+
 	if v, ok := v.(api.Battery); ok {
 		var soc float64
 		var err error
@@ -223,6 +223,20 @@ func configureMeters(static []config.Named, names ...string) error {
 	return nil
 }
 
+// chargerInstance creates the charger and passes the evcc version, e.g. for reporting to external monitoring
+func chargerInstance(cc config.Named) (api.Charger, error) {
+	instance, err := charger.NewFromConfig(cc.Type, cc.Other)
+	if err != nil {
+		return nil, err
+	}
+
+	if c, ok := instance.(interface{ SetVersion(string) }); ok {
+		c.SetVersion(server.FormattedVersion())
+	}
+
+	return instance, nil
+}
+
 func configureChargers(static []config.Named, names ...string) error {
 	g, _ := errgroup.WithContext(context.Background())
 
@@ -237,7 +251,7 @@ func configureChargers(static []config.Named, names ...string) error {
 
 		cc := cc
 		g.Go(func() error {
-			instance, err := charger.NewFromConfig(cc.Type, cc.Other)
+			instance, err := chargerInstance(cc)
 			if err != nil {
 				return fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)
 			}
@@ -261,7 +275,7 @@ func configureChargers(static []config.Named, names ...string) error {
 				return nil
 			}
 
-			instance, err := charger.NewFromConfig(cc.Type, cc.Other)
+			instance, err := chargerInstance(cc)
 			if err != nil {
 				return fmt.Errorf("cannot create charger '%s': %w", cc.Name, err)
 			}
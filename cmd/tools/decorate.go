@@ -21,6 +21,7 @@ var srcTmpl string
 
 type dynamicType struct {
 	typ, function, signature string
+	with                     string // decorated together with this type
 }
 
 type typeStruct struct {
@@ -30,6 +31,8 @@ type typeStruct struct {
 
 func generate(out io.Writer, packageName, functionName, baseType string, dynamicTypes ...dynamicType) error {
 	types := make(map[string]typeStruct, len(dynamicTypes))
+	leaders := make(map[string]typeStruct, len(dynamicTypes))
+	followers := make(map[string][]string)
 	combos := make([]string, 0)
 
 	tmpl, err := template.New("gen").Funcs(template.FuncMap{
@@ -90,9 +93,35 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 			ReturnTypes: returnValuesStr,
 		}
 
+		// followers are decorated if their leader is, only the leader is checked for nil
+		if dt.with != "" {
+			followers[dt.with] = append(followers[dt.with], dt.typ)
+			continue
+		}
+
+		leaders[dt.typ] = types[dt.typ]
 		combos = append(combos, dt.typ)
 	}
 
+	following := make(map[string][]typeStruct, len(followers))
+	for leader, typs := range followers {
+		if _, ok := leaders[leader]; !ok {
+			return fmt.Errorf("invalid leader type: %s", leader)
+		}
+		for _, typ := range typs {
+			following[leader] = append(following[leader], types[typ])
+		}
+	}
+
+	var all [][]string
+	for _, combo := range combinations.All(combos) {
+		res := slices.Clone(combo)
+		for _, typ := range combo {
+			res = append(res, followers[typ]...)
+		}
+		all = append(all, res)
+	}
+
 	returnType := *ret
 	if returnType == "" {
 		returnType = baseType
@@ -109,6 +138,8 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 		BaseType, ShortBase string
 		ReturnType          string
 		Types               map[string]typeStruct
+		Leaders             map[string]typeStruct
+		Followers           map[string][]typeStruct
 		Combinations        [][]string
 	}{
 		API:          "github.com/evcc-io/evcc/api",
@@ -118,7 +149,9 @@ func generate(out io.Writer, packageName, functionName, baseType string, dynamic
 		ShortBase:    shortBase,
 		ReturnType:   returnType,
 		Types:        types,
-		Combinations: combinations.All(combos),
+		Leaders:      leaders,
+		Followers:    following,
+		Combinations: all,
 	}
 
 	return tmpl.Execute(out, vars)
@@ -131,6 +164,7 @@ var (
 	base     = pflag.StringP("base", "b", "", "base type")
 	ret      = pflag.StringP("return", "r", "", "return type")
 	types    = pflag.StringArrayP("type", "t", nil, "comma-separated list of type definitions")
+	with     = pflag.StringArrayP("with", "w", nil, "type decorated together with leading type, e.g. follower=leader. Followers are dropped without their leader and must be provided with it, the generated function panics otherwise")
 )
 
 // Usage prints flags usage
@@ -155,10 +189,20 @@ func main() {
 		os.Exit(2)
 	}
 
+	leader := make(map[string]string)
+	for _, v := range *with {
+		follower, typ, ok := strings.Cut(v, "=")
+		if !ok {
+			Usage()
+			os.Exit(2)
+		}
+		leader[follower] = typ
+	}
+
 	var dynamicTypes []dynamicType
 	for _, v := range *types {
 		split := strings.SplitN(v, ",", 3)
-		dt := dynamicType{split[0], split[1], split[2]}
+		dt := dynamicType{split[0], split[1], split[2], leader[split[0]]}
 		dynamicTypes = append(dynamicTypes, dt)
 	}
 
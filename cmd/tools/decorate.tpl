@@ -11,7 +11,7 @@ import (
 	{{- $prefix := .Prefix}}
 	{{- $idx := 0}}
 
-	{{- range $typ, $def := .Types}}
+	{{- range $typ, $def := .Leaders}}
 		{{- if gt $idx 0}} &&{{else}}{{$idx = 1}}{{end}} {{$def.VarName}} {{if contains $combo $typ}}!={{else}}=={{end}} nil
 	{{- end}}:
 		return &struct {
@@ -38,14 +38,22 @@ func {{.Function}}(base {{.BaseType}}{{range ordered}}, {{.VarName}} {{.Signatur
 {{- $shortbase := .ShortBase}}
 {{- $prefix := .Function}}
 {{- $types := .Types}}
+{{- $leaders := .Leaders}}
+{{- range $typ, $followers := .Followers}}
+	if {{(index $leaders $typ).VarName}} != nil && {{if gt (len $followers) 1}}({{end}}
+		{{- range $i, $def := $followers}}{{if gt $i 0}} || {{end}}{{$def.VarName}} == nil{{end -}}
+	{{if gt (len $followers) 1}}){{end}} {
+		panic("{{$prefix}}: {{$typ}} requires {{range $i, $def := $followers}}{{if gt $i 0}}, {{end}}{{$def.Type}}{{end}}")
+	}
+{{end}}
 {{- $idx := 0}}
 	switch {
-	case {{- range $typ, $def := .Types}}
+	case {{- range $typ, $def := .Leaders}}
 		{{- if gt $idx 0}} &&{{else}}{{$idx = 1}}{{end}} {{$def.VarName}} == nil
 	{{- end}}:
 		return base
 {{range $combo := .Combinations}}
-	case {{- template "case" dict "BaseType" $basetype "Prefix" $prefix "ShortBase" $shortbase "Types" $types "Combo" $combo}}
+	case {{- template "case" dict "BaseType" $basetype "Prefix" $prefix "ShortBase" $shortbase "Types" $types "Leaders" $leaders "Combo" $combo}}
 {{end}}	}
 
 	return nil
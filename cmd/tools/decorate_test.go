@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateWith(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, generate(&buf, "charger", "decorateFoo", "*Foo",
+		dynamicType{"api.Meter", "CurrentPower", "func() (float64, error)", ""},
+		dynamicType{"api.MeterEnergy", "TotalEnergy", "func() (float64, error)", "api.Meter"},
+		dynamicType{"api.Identifier", "Identify", "func() (string, error)", ""},
+	))
+
+	src, err := format.Source(buf.Bytes())
+	require.NoError(t, err)
+	res := string(src)
+
+	// only leaders are combined
+	assert.Equal(t, 4, strings.Count(res, "\tcase "))
+	assert.NotContains(t, res, "meterEnergy != nil")
+
+	// followers must be provided with their leader
+	assert.Contains(t, res, "if meter != nil && meterEnergy == nil {\n\t\tpanic(\"decorateFoo: api.Meter requires api.MeterEnergy\")")
+
+	// followers are decorated with their leader
+	assert.Contains(t, res, "case identifier == nil && meter != nil:\n\t\treturn &struct {\n\t\t\t*Foo\n\t\t\tapi.Meter\n\t\t\tapi.MeterEnergy\n\t\t}")
+}
+
+func TestGenerateWithout(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, generate(&buf, "charger", "decorateFoo", "*Foo",
+		dynamicType{"api.Meter", "CurrentPower", "func() (float64, error)", ""},
+		dynamicType{"api.MeterEnergy", "TotalEnergy", "func() (float64, error)", ""},
+	))
+
+	assert.NotContains(t, buf.String(), "panic")
+	assert.Equal(t, 4, strings.Count(buf.String(), "\tcase "))
+}
+
+func TestGenerateInvalidLeader(t *testing.T) {
+	var buf bytes.Buffer
+	assert.ErrorContains(t, generate(&buf, "charger", "decorateFoo", "*Foo",
+		dynamicType{"api.MeterEnergy", "TotalEnergy", "func() (float64, error)", "api.Meter"},
+	), "invalid leader type")
+}
@@ -20,6 +20,12 @@ const (
 	ChargerIcon    = "chargerIcon"    // charger icon for ui
 	ChargerFeature = "chargerFeature" // charger feature
 
+	ChargerSetpointRequested     = "chargerSetpointRequested"     // last requested charger current
+	ChargerSetpointConfirmed     = "chargerSetpointConfirmed"     // last confirmed charger current
+	ChargerSetpointConfirmedTime = "chargerSetpointConfirmedTime" // last confirmed charger current time
+	ChargerVehicleCurrent        = "chargerVehicleCurrent"        // current requested by the vehicle
+	ChargerAuthorized            = "chargerAuthorized"            // vehicle authorized to charge
+
 	// loadpoint status
 	Enabled   = "enabled"   // loadpoint enabled
 	Connected = "connected" // connected
@@ -57,6 +57,9 @@ const (
 	guardGracePeriod          = 60 * time.Second // allow out of sync during this timespan
 	phaseSwitchCommandTimeout = 30 * time.Second // do not sync charger enabled/disabled state during this timespan
 	phaseSwitchDuration       = 60 * time.Second // do not measure phases during this timespan
+
+	faultBackoff    = 30 * time.Second // initial retry period for transient charger faults
+	maxFaultBackoff = 5 * time.Minute  // maximum retry period for transient charger faults
 )
 
 // elapsed is the time an expired timer will be set to
@@ -163,6 +166,8 @@ type Loadpoint struct {
 	pvTimer        time.Time              // PV enabled/disable timer
 	phaseTimer     time.Time              // 1p3p switch timer
 	wakeUpTimer    *Timer                 // Vehicle wake-up timeout
+	faultTime      time.Time              // Transient charger fault timestamp
+	faultRetries   int                    // Transient charger faults during session
 
 	// charge progress
 	vehicleSoc              float64        // Vehicle Soc
@@ -703,7 +708,7 @@ func (lp *Loadpoint) syncCharger() error {
 // setLimit applies charger current limits and enables/disables accordingly
 func (lp *Loadpoint) setLimit(chargeCurrent float64, force bool) error {
 	// full amps only?
-	if _, ok := lp.charger.(api.ChargerEx); !ok || lp.vehicleHasFeature(api.CoarseCurrent) {
+	if _, ok := lp.charger.(api.ChargerEx); !ok || lp.vehicleHasFeature(api.CoarseCurrent) || lp.chargerHasFeature(api.CoarseCurrent) {
 		chargeCurrent = math.Trunc(chargeCurrent)
 	}
 
@@ -895,6 +900,12 @@ func statusEvents(prevStatus, status api.ChargeStatus) []string {
 
 // updateChargerStatus updates charger status and detects car connected/disconnected events
 func (lp *Loadpoint) updateChargerStatus() error {
+	// read transitions before the status to keep them in order
+	var transitions []api.ChargeStatus
+	if c, ok := lp.charger.(api.StatusTransitions); ok {
+		transitions = c.Transitions()
+	}
+
 	status, err := lp.charger.Status()
 	if err != nil {
 		return err
@@ -902,28 +913,45 @@ func (lp *Loadpoint) updateChargerStatus() error {
 
 	lp.log.DEBUG.Printf("charger status: %s", status)
 
-	if prevStatus := lp.GetStatus(); status != prevStatus {
-		lp.setStatus(status)
+	status = lp.faultStatus(status)
 
-		for _, ev := range statusEvents(prevStatus, status) {
-			lp.bus.Publish(ev)
+	// replay transitions missed between updates
+	for _, s := range transitions {
+		if s == api.StatusA || s == api.StatusB || s == api.StatusC {
+			lp.applyStatus(s)
+		}
+	}
 
-			// send connect/disconnect events except during startup
-			if prevStatus != api.StatusNone {
-				switch ev {
-				case evVehicleConnect:
-					lp.pushEvent(evVehicleConnect)
-				case evVehicleDisconnect:
-					lp.pushEvent(evVehicleDisconnect)
-				}
+	lp.applyStatus(status)
+
+	return nil
+}
+
+// applyStatus sets the charger status and publishes the resulting events
+func (lp *Loadpoint) applyStatus(status api.ChargeStatus) {
+	prevStatus := lp.GetStatus()
+	if status == prevStatus {
+		return
+	}
+
+	lp.setStatus(status)
+
+	for _, ev := range statusEvents(prevStatus, status) {
+		lp.bus.Publish(ev)
+
+		// send connect/disconnect events except during startup
+		if prevStatus != api.StatusNone {
+			switch ev {
+			case evVehicleConnect:
+				lp.pushEvent(evVehicleConnect)
+			case evVehicleDisconnect:
+				lp.pushEvent(evVehicleDisconnect)
 			}
 		}
-
-		// update whenever there is a state change
-		lp.bus.Publish(evChargeCurrent, lp.chargeCurrent)
 	}
 
-	return nil
+	// update whenever there is a state change
+	lp.bus.Publish(evChargeCurrent, lp.chargeCurrent)
 }
 
 // effectiveCurrent returns the currently effective charging current
@@ -1008,6 +1036,8 @@ func (lp *Loadpoint) scalePhases(phases int) error {
 		panic("charger does not implement api.PhaseSwitcher")
 	}
 
+	lp.syncChargerPhases()
+
 	if lp.GetPhases() != phases {
 		// switch phases
 		if err := cp.Phases1p3p(phases); err != nil {
@@ -1037,6 +1067,7 @@ func (lp *Loadpoint) fastCharging() error {
 
 // pvScalePhases switches phases if necessary and returns if switch occurred
 func (lp *Loadpoint) pvScalePhases(sitePower, minCurrent, maxCurrent float64) bool {
+	lp.syncChargerPhases()
 	phases := lp.GetPhases()
 
 	// observed phase state inconsistency
@@ -1528,7 +1559,11 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 
 	// read and publish status
 	if err := lp.updateChargerStatus(); err != nil {
-		lp.log.ERROR.Printf("charger: %v", err)
+		if errors.Is(err, api.ErrUpdating) {
+			lp.log.DEBUG.Printf("charger: %v", err)
+		} else {
+			lp.log.ERROR.Printf("charger: %v", err)
+		}
 		return
 	}
 
@@ -1556,6 +1591,8 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 		return
 	}
 
+	lp.publishChargerSetpoints()
+
 	// check if car connected and ready for charging
 	var err error
 
@@ -1575,6 +1612,10 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 		// https://github.com/evcc-io/evcc/issues/105
 		err = lp.setLimit(0, false)
 
+	case !lp.chargerAuthorized():
+		// vehicle waiting for authorization at the charger
+		err = lp.setLimit(0, false)
+
 	case lp.scalePhasesRequired():
 		err = lp.scalePhases(lp.ConfiguredPhases)
 
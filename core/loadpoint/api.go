@@ -126,6 +126,8 @@ type API interface {
 	GetRemainingDuration() time.Duration
 	// GetRemainingEnergy is the remaining charge energy in Wh
 	GetRemainingEnergy() float64
+	// GetSoc returns the vehicle soc
+	GetSoc() float64
 
 	//
 	// vehicles
@@ -394,6 +394,13 @@ func (lp *Loadpoint) setRemainingDuration(remainingDuration time.Duration) {
 	}
 }
 
+// GetSoc returns the vehicle soc
+func (lp *Loadpoint) GetSoc() float64 {
+	lp.RLock()
+	defer lp.RUnlock()
+	return lp.vehicleSoc
+}
+
 // GetRemainingEnergy is the remaining charge energy in Wh
 func (lp *Loadpoint) GetRemainingEnergy() float64 {
 	lp.RLock()
@@ -1,7 +1,9 @@
 package core
 
 import (
+	"errors"
 	"slices"
+	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/keys"
@@ -26,6 +28,89 @@ func (lp *Loadpoint) publishChargerFeature(f api.Feature) {
 	lp.publish(keys.ChargerFeature+f.String(), ok)
 }
 
+// publishChargerSetpoints publishes requested and confirmed charger setpoints if available
+func (lp *Loadpoint) publishChargerSetpoints() {
+	if c, ok := lp.charger.(api.SetpointStatus); ok {
+		if requested, confirmed, err := c.Setpoints(); err == nil {
+			lp.publish(keys.ChargerSetpointRequested, requested.Current)
+			lp.publish(keys.ChargerSetpointConfirmed, confirmed.Current)
+			lp.publish(keys.ChargerSetpointConfirmedTime, confirmed.Updated)
+		}
+	}
+
+	// vehicle limiting itself below the offered current
+	if c, ok := lp.charger.(api.VehicleCurrentGetter); ok {
+		if current, err := c.GetVehicleCurrent(); err == nil {
+			lp.publish(keys.ChargerVehicleCurrent, current)
+			if lp.enabled && current < lp.chargeCurrent {
+				lp.log.DEBUG.Printf("vehicle current: %.3gA limited by vehicle below offered %.3gA", current, lp.chargeCurrent)
+			}
+		}
+	}
+}
+
+// chargerAuthorized returns false if the charger reports the connected vehicle as not authorized to charge.
+// Chargers without authorization support and read errors are treated as authorized.
+func (lp *Loadpoint) chargerAuthorized() bool {
+	c, ok := lp.charger.(api.AuthorizationStatus)
+	if !ok {
+		return true
+	}
+
+	authorized, err := c.Authorized()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("charger authorized: %v", err)
+		}
+		return true
+	}
+
+	lp.publish(keys.ChargerAuthorized, authorized)
+
+	return authorized
+}
+
+// faultStatus applies the retry policy for charger faults. During transient faults the previous status is kept
+// for an increasing backoff period, allowing the charger to recover without terminating the session.
+// Faults are considered persistent unless classified by the charger.
+func (lp *Loadpoint) faultStatus(status api.ChargeStatus) api.ChargeStatus {
+	if status != api.StatusF {
+		lp.faultTime = time.Time{}
+		if status == api.StatusA {
+			lp.faultRetries = 0
+		}
+		return status
+	}
+
+	var transient bool
+	if c, ok := lp.charger.(api.FaultClassifier); ok {
+		var err error
+		if transient, err = c.TransientFault(); err != nil {
+			lp.log.ERROR.Printf("charger fault: %v", err)
+		}
+	}
+
+	prev := lp.GetStatus()
+	if !transient || (prev != api.StatusB && prev != api.StatusC) {
+		return status
+	}
+
+	if lp.faultTime.IsZero() {
+		lp.faultTime = lp.clock.Now()
+		lp.faultRetries++
+	}
+
+	backoff := min(faultBackoff<<min(lp.faultRetries-1, 8), maxFaultBackoff)
+	if elapsed := lp.clock.Since(lp.faultTime); elapsed < backoff {
+		lp.log.WARN.Printf("charger fault: transient, retrying for %v", (backoff - elapsed).Round(time.Second))
+		return prev
+	}
+
+	lp.log.ERROR.Printf("charger fault: not recovered within %v", backoff)
+
+	return status
+}
+
 // chargerSoc returns charger soc if available
 func (lp *Loadpoint) chargerSoc() (float64, error) {
 	if c, ok := lp.charger.(api.Battery); ok {
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"errors"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/keys"
 )
@@ -38,6 +40,27 @@ func (lp *Loadpoint) setPhases(phases int) {
 	}
 }
 
+// syncChargerPhases reconciles enabled phases with the charger's actual phases, e.g. after external switching
+func (lp *Loadpoint) syncChargerPhases() {
+	cp, ok := lp.charger.(api.PhaseGetter)
+	if !ok {
+		return
+	}
+
+	phases, err := cp.GetPhases()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("charger get phases: %v", err)
+		}
+		return
+	}
+
+	if phases != lp.GetPhases() {
+		lp.log.WARN.Printf("charger phases changed externally: %dp", phases)
+		lp.setPhases(phases)
+	}
+}
+
 // resetMeasuredPhases resets measured phases to unknown on vehicle disconnect, phase switch or phase api call
 func (lp *Loadpoint) resetMeasuredPhases() {
 	lp.Lock()
@@ -446,3 +446,40 @@ func TestScalePhasesIfAvailable(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+type phaseGetter int
+
+func (p phaseGetter) GetPhases() (int, error) {
+	return int(p), nil
+}
+
+func TestScalePhasesExternallySwitched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	phaseCharger := api.NewMockPhaseSwitcher(ctrl)
+
+	lp := &Loadpoint{
+		log:   util.NewLogger("foo"),
+		clock: clock.NewMock(),
+		charger: struct {
+			*api.MockCharger
+			*api.MockPhaseSwitcher
+			phaseGetter
+		}{
+			api.NewMockCharger(ctrl),
+			phaseCharger,
+			phaseGetter(3),
+		},
+		MinCurrent: minA,
+		phases:     1,
+	}
+
+	// charger already switched to 3p externally
+	if err := lp.scalePhases(3); err != nil {
+		t.Error(err)
+	}
+
+	if lp.phases != 3 {
+		t.Errorf("expected 3p, got %dp", lp.phases)
+	}
+}
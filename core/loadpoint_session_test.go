@@ -88,6 +88,42 @@ func TestSession(t *testing.T) {
 	t.Logf("session: %+v", s)
 }
 
+func TestSessionIdentifier(t *testing.T) {
+	var err error
+	serverdb.Instance, err = serverdb.New("sqlite", ":memory:")
+	require.NoError(t, err)
+
+	db, err := session.NewStore("foo", serverdb.Instance)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+
+	type IdentifierDecorator struct {
+		api.Charger
+		api.Identifier
+	}
+
+	id := api.NewMockIdentifier(ctrl)
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		clock:         clock.NewMock(),
+		db:            db,
+		charger:       &IdentifierDecorator{Charger: api.NewMockCharger(ctrl), Identifier: id},
+		sessionEnergy: NewEnergyMetrics(),
+	}
+
+	// not yet identified on connect
+	id.EXPECT().Identify().Return("", nil)
+	lp.createSession()
+	assert.Empty(t, lp.session.Identifier)
+
+	// tag presented after connect
+	id.EXPECT().Identify().Return("tag", nil)
+	lp.identifyVehicle()
+	assert.Equal(t, "tag", lp.session.Identifier)
+}
+
 func TestCloseSessionsOnStartup_emptyDb(t *testing.T) {
 	var err error
 	serverdb.Instance, err = serverdb.New("sqlite", ":memory:")
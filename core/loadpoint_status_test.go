@@ -3,8 +3,14 @@ package core
 import (
 	"testing"
 
+	evbus "github.com/asaskevich/EventBus"
+	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestStatusEvents(t *testing.T) {
@@ -31,3 +37,129 @@ func TestStatusEvents(t *testing.T) {
 		assert.Equalf(t, tc.events, ev, "from %s to %s got: %v", tc.from, tc.to, ev)
 	}
 }
+
+type faultCharger struct {
+	*api.MockCharger
+	transient bool
+}
+
+func (c *faultCharger) TransientFault() (bool, error) {
+	return c.transient, nil
+}
+
+func TestFaultStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	charger := &faultCharger{MockCharger: api.NewMockCharger(ctrl), transient: true}
+
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		clock:   clck,
+		charger: charger,
+		status:  api.StatusC,
+	}
+
+	// transient fault keeps status during backoff
+	assert.Equal(t, api.StatusC, lp.faultStatus(api.StatusF))
+	clck.Add(faultBackoff)
+	assert.Equal(t, api.StatusF, lp.faultStatus(api.StatusF))
+
+	// recovered, next fault doubles backoff
+	assert.Equal(t, api.StatusC, lp.faultStatus(api.StatusC))
+	assert.Equal(t, api.StatusC, lp.faultStatus(api.StatusF))
+	clck.Add(faultBackoff)
+	assert.Equal(t, api.StatusC, lp.faultStatus(api.StatusF))
+	clck.Add(faultBackoff)
+	assert.Equal(t, api.StatusF, lp.faultStatus(api.StatusF))
+
+	// persistent fault
+	lp.faultStatus(api.StatusC)
+	charger.transient = false
+	assert.Equal(t, api.StatusF, lp.faultStatus(api.StatusF))
+}
+
+type authCharger struct {
+	*api.MockCharger
+	authorized bool
+	err        error
+}
+
+func (c *authCharger) Authorized() (bool, error) {
+	return c.authorized, c.err
+}
+
+func TestChargerAuthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// charger without authorization support
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		charger: api.NewMockCharger(ctrl),
+	}
+	assert.True(t, lp.chargerAuthorized())
+
+	charger := &authCharger{MockCharger: api.NewMockCharger(ctrl)}
+	lp.charger = charger
+	assert.False(t, lp.chargerAuthorized())
+
+	charger.authorized = true
+	assert.True(t, lp.chargerAuthorized())
+
+	// read errors don't block charging
+	charger.authorized = false
+	charger.err = api.ErrTimeout
+	assert.True(t, lp.chargerAuthorized())
+}
+
+type transitionCharger struct {
+	*api.MockCharger
+	transitions []api.ChargeStatus
+}
+
+func (c *transitionCharger) Transitions() []api.ChargeStatus {
+	res := c.transitions
+	c.transitions = nil
+	return res
+}
+
+func TestStatusTransitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	charger := &transitionCharger{MockCharger: api.NewMockCharger(ctrl)}
+	charger.MockCharger.EXPECT().Status().Return(api.StatusB, nil).AnyTimes()
+
+	pushChan := make(chan push.Event, 10)
+
+	lp := &Loadpoint{
+		log:      util.NewLogger("foo"),
+		bus:      evbus.New(),
+		pushChan: pushChan,
+		charger:  charger,
+		status:   api.StatusB,
+	}
+
+	var events []string
+	for _, ev := range []string{evVehicleConnect, evVehicleDisconnect, evChargeStart, evChargeStop} {
+		ev := ev
+		require.NoError(t, lp.bus.Subscribe(ev, func() { events = append(events, ev) }))
+	}
+
+	// short charging between updates
+	charger.transitions = []api.ChargeStatus{api.StatusC, api.StatusB}
+	require.NoError(t, lp.updateChargerStatus())
+	assert.Equal(t, []string{evChargeStart, evChargeStop}, events)
+
+	// fast unplug and replug
+	events = nil
+	charger.transitions = []api.ChargeStatus{api.StatusA, api.StatusB}
+	require.NoError(t, lp.updateChargerStatus())
+	assert.Equal(t, []string{evVehicleDisconnect, evVehicleConnect}, events)
+	assert.Len(t, pushChan, 2)
+
+	// no transitions
+	events = nil
+	require.NoError(t, lp.updateChargerStatus())
+	assert.Empty(t, events)
+	assert.Equal(t, api.StatusB, lp.GetStatus())
+}
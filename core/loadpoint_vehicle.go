@@ -59,6 +59,11 @@ func (lp *Loadpoint) identifyVehicle() {
 	if id != "" {
 		lp.log.DEBUG.Println("charger vehicle id:", id)
 
+		// tags are usually presented after the session was created on connect
+		lp.updateSession(func(session *session.Session) {
+			session.Identifier = id
+		})
+
 		if vehicle := lp.selectVehicleByID(id); vehicle != nil {
 			lp.stopVehicleDetection()
 			lp.setActiveVehicle(vehicle)
@@ -727,6 +727,7 @@ func (site *Site) publishTariffs(greenShareHome float64, greenShareLoadpoints fl
 
 	if gridPrice, err := site.tariffs.CurrentGridPrice(); err == nil {
 		site.publishDelta(keys.TariffGrid, gridPrice)
+		site.displayPrice(gridPrice)
 	}
 	if feedInPrice, err := site.tariffs.CurrentFeedInPrice(); err == nil {
 		site.publishDelta(keys.TariffFeedIn, feedInPrice)
@@ -748,6 +749,17 @@ func (site *Site) publishTariffs(greenShareHome float64, greenShareLoadpoints fl
 	}
 }
 
+// displayPrice shows the grid price on chargers with price display
+func (site *Site) displayPrice(price float64) {
+	for _, lp := range site.loadpoints {
+		if pd, ok := lp.charger.(api.PriceDisplay); ok {
+			if err := pd.SetPrice(price, site.tariffs.Currency.String()); err != nil && !errors.Is(err, api.ErrNotAvailable) {
+				lp.log.ERROR.Printf("price display: %v", err)
+			}
+		}
+	}
+}
+
 func (site *Site) update(lp Updater) {
 	site.log.DEBUG.Println("----")
 
@@ -0,0 +1,66 @@
+package meter
+
+import (
+	"sync"
+
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Monotonic guards a lifetime energy reading against decreasing values
+type Monotonic struct {
+	log     *util.Logger
+	mu      sync.Mutex
+	key     string
+	reset   float64
+	last    *float64
+	energyG func() (float64, error)
+}
+
+// NewMonotonic wraps a lifetime energy getter. Readings below the last good value are rejected and the
+// last good value is returned instead, unless the reading drops below the reset threshold which is
+// accepted as legitimate meter reset. If key is not empty, the last good value is persisted in settings.
+func NewMonotonic(log *util.Logger, key string, reset float64, energyG func() (float64, error)) *Monotonic {
+	m := &Monotonic{
+		log:     log,
+		key:     key,
+		reset:   reset,
+		energyG: energyG,
+	}
+
+	if key != "" {
+		if v, err := settings.Float(key); err == nil {
+			m.last = &v
+		}
+	}
+
+	return m
+}
+
+// TotalEnergy implements the api.MeterEnergy interface
+func (m *Monotonic) TotalEnergy() (float64, error) {
+	v, err := m.energyG()
+	if err != nil {
+		return v, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.last != nil && v < *m.last {
+		if v >= m.reset {
+			m.log.WARN.Printf("energy decreased from %.3fkWh to %.3fkWh, using last value", *m.last, v)
+			return *m.last, nil
+		}
+
+		m.log.WARN.Printf("energy reset from %.3fkWh to %.3fkWh", *m.last, v)
+	}
+
+	m.last = &v
+
+	if m.key != "" {
+		settings.SetFloat(m.key, v)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,49 @@
+package meter
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/server/db/settings"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonotonic(t *testing.T) {
+	var energy float64
+
+	m := NewMonotonic(util.NewLogger("foo"), "", 0.1, func() (float64, error) {
+		return energy, nil
+	})
+
+	for _, tc := range []struct {
+		energy, expected float64
+	}{
+		{10, 10},
+		{11, 11},
+		// glitch
+		{5, 11},
+		{12, 12},
+		// reset
+		{0.05, 0.05},
+		{1, 1},
+	} {
+		energy = tc.energy
+
+		res, err := m.TotalEnergy()
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, res, tc)
+	}
+}
+
+func TestMonotonicPersist(t *testing.T) {
+	settings.SetFloat("monotonic.test", 10)
+
+	m := NewMonotonic(util.NewLogger("foo"), "monotonic.test", 0.1, func() (float64, error) {
+		return 5, nil
+	})
+
+	res, err := m.TotalEnergy()
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, res)
+}
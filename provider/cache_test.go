@@ -2,6 +2,8 @@ package provider
 
 import (
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -128,3 +130,29 @@ func TestRetryWithBackoff(t *testing.T) {
 		assert.Equal(t, tt.functionCalled, functionCalled)
 	}
 }
+
+func TestCachedConcurrent(t *testing.T) {
+	var calls atomic.Int32
+
+	g := func() (int64, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return 1, nil
+	}
+
+	c := Cached(g, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), res)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/evcc-io/evcc/provider/mqtt"
@@ -14,9 +16,14 @@ type Mqtt struct {
 	client   *mqtt.Client
 	topic    string
 	retained bool
+	qos      *byte
 	payload  string
 	scale    float64
+	truthy   []string
+	falsy    []string
 	timeout  time.Duration
+	dedup    time.Duration
+	array    arraySettings
 	pipeline *pipeline.Pipeline
 }
 
@@ -30,8 +37,12 @@ func NewMqttFromConfig(other map[string]interface{}) (Provider, error) {
 		mqtt.Config       `mapstructure:",squash"`
 		Topic, Payload    string // Payload only applies to setters
 		Retained          bool
+		Qos               *byte // subscribe and publish qos, defaults to client qos
 		Scale             float64
+		True, False       []string // bool getter payloads
 		Timeout           time.Duration
+		Delimiter         string // delimited numeric payloads
+		Fields, Index     int    // expected field count and field read by float getters
 		pipeline.Settings `mapstructure:",squash"`
 	}{
 		Scale: 1,
@@ -48,9 +59,18 @@ func NewMqttFromConfig(other map[string]interface{}) (Provider, error) {
 		return nil, err
 	}
 
-	m := NewMqtt(log, client, cc.Topic, cc.Timeout).WithScale(cc.Scale).WithPayload(cc.Payload)
-	if cc.Retained {
-		m = m.WithRetained()
+	m := NewMqtt(log, client, cc.Topic, cc.Timeout).WithScale(cc.Scale).WithPayload(cc.Payload).WithBoolPayloads(cc.True, cc.False).WithRetained(cc.Retained)
+	if cc.Qos != nil {
+		if *cc.Qos > 2 {
+			return nil, fmt.Errorf("invalid qos: %d", *cc.Qos)
+		}
+		m = m.WithQoS(*cc.Qos)
+	}
+	if cc.Delimiter != "" {
+		if cc.Index < 0 || (cc.Fields > 0 && cc.Index >= cc.Fields) {
+			return nil, fmt.Errorf("invalid index: %d", cc.Index)
+		}
+		m = m.WithDelimiter(cc.Delimiter, cc.Fields).WithIndex(cc.Index)
 	}
 
 	pipe, err := pipeline.New(log, cc.Settings)
@@ -80,9 +100,15 @@ func (m *Mqtt) WithPayload(payload string) *Mqtt {
 	return m
 }
 
-// WithRetained adds retained flag for setters
-func (m *Mqtt) WithRetained() *Mqtt {
-	m.retained = true
+// WithRetained sets the retained flag for setters
+func (m *Mqtt) WithRetained(retained bool) *Mqtt {
+	m.retained = retained
+	return m
+}
+
+// WithQoS sets the qos for subscribing and publishing instead of the client qos
+func (m *Mqtt) WithQoS(qos byte) *Mqtt {
+	m.qos = &qos
 	return m
 }
 
@@ -92,6 +118,35 @@ func (m *Mqtt) WithScale(scale float64) *Mqtt {
 	return m
 }
 
+// WithBoolPayloads sets the payloads matched by bool getters. Matching is case-insensitive.
+// If no false payloads are given, any payload not matching true is false.
+func (m *Mqtt) WithBoolPayloads(truthy, falsy []string) *Mqtt {
+	m.truthy = truthy
+	m.falsy = falsy
+	return m
+}
+
+// WithStartupDedup ignores payloads identical to the previous payload within window after the first message,
+// e.g. a retained message immediately followed by the same live message
+func (m *Mqtt) WithStartupDedup(window time.Duration) *Mqtt {
+	m.dedup = window
+	return m
+}
+
+// WithDelimiter parses payloads as delimited numeric fields. If fields is not zero, payloads must contain exactly
+// that many fields. Float and int getters read the field selected by WithIndex.
+func (m *Mqtt) WithDelimiter(delimiter string, fields int) *Mqtt {
+	m.array.delimiter = delimiter
+	m.array.fields = fields
+	return m
+}
+
+// WithIndex selects the delimited field read by float and int getters
+func (m *Mqtt) WithIndex(index int) *Mqtt {
+	m.array.index = index
+	return m
+}
+
 // WithPipeline adds a processing pipeline
 func (p *Mqtt) WithPipeline(pipeline *pipeline.Pipeline) *Mqtt {
 	p.pipeline = pipeline
@@ -103,14 +158,30 @@ func (m *Mqtt) newReceiver() (*msgHandler, error) {
 	h := &msgHandler{
 		topic:    m.topic,
 		scale:    m.scale,
+		truthy:   m.truthy,
+		falsy:    m.falsy,
+		dedup:    m.dedup,
+		array:    m.array,
 		pipeline: m.pipeline,
 		val:      util.NewMonitor[string](m.timeout),
 	}
 
+	if m.qos != nil {
+		return h, m.client.ListenQoS(m.topic, *m.qos, h.receive)
+	}
+
 	err := m.client.Listen(m.topic, h.receive)
 	return h, err
 }
 
+// publish publishes the setter payload
+func (m *Mqtt) publish(payload string) error {
+	if m.qos != nil {
+		return m.client.PublishQoS(m.topic, *m.qos, m.retained, payload)
+	}
+	return m.client.Publish(m.topic, m.retained, payload)
+}
+
 var _ FloatProvider = (*Mqtt)(nil)
 
 // FloatGetter creates handler for float64 from MQTT topic that returns cached value
@@ -119,6 +190,16 @@ func (m *Mqtt) FloatGetter() (func() (float64, error), error) {
 	return h.floatGetter, err
 }
 
+// FloatsGetter creates handler for all fields of a delimited numeric payload, see WithDelimiter
+func (m *Mqtt) FloatsGetter() (func() ([]float64, error), error) {
+	if m.array.delimiter == "" {
+		return nil, errors.New("missing delimiter")
+	}
+
+	h, err := m.newReceiver()
+	return h.floatsGetter, err
+}
+
 var _ IntProvider = (*Mqtt)(nil)
 
 // IntGetter creates handler for int64 from MQTT topic that returns cached value
@@ -137,7 +218,7 @@ func (m *Mqtt) StringGetter() (func() (string, error), error) {
 
 var _ BoolProvider = (*Mqtt)(nil)
 
-// BoolGetter creates handler for string from MQTT topic that returns cached value
+// BoolGetter creates handler for bool from MQTT topic that returns cached value
 func (m *Mqtt) BoolGetter() (func() (bool, error), error) {
 	h, err := m.newReceiver()
 	return h.boolGetter, err
@@ -153,7 +234,7 @@ func (m *Mqtt) IntSetter(param string) (func(int64) error, error) {
 			return err
 		}
 
-		return m.client.Publish(m.topic, m.retained, payload)
+		return m.publish(payload)
 	}, nil
 }
 
@@ -167,7 +248,7 @@ func (m *Mqtt) BoolSetter(param string) (func(bool) error, error) {
 			return err
 		}
 
-		return m.client.Publish(m.topic, m.retained, payload)
+		return m.publish(payload)
 	}, nil
 }
 
@@ -181,6 +262,6 @@ func (m *Mqtt) StringSetter(param string) (func(string) error, error) {
 			return err
 		}
 
-		return m.client.Publish(m.topic, m.retained, payload)
+		return m.publish(payload)
 	}, nil
 }
@@ -0,0 +1,59 @@
+package mqtt
+
+import (
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/util"
+)
+
+// NewAdapterClient creates a client using a transport other than a broker connection, e.g. a device specific
+// event stream. The transport implements the paho.Client interface.
+func NewAdapterClient(log *util.Logger, broker string, client paho.Client) *Client {
+	return &Client{
+		log:      log,
+		Client:   client,
+		broker:   broker,
+		listener: make(map[string][]*func(string)),
+	}
+}
+
+// NewMessage creates a message delivered by adapter transports
+func NewMessage(topic, payload string, retained bool) paho.Message {
+	return &message{topic: topic, payload: payload, retained: retained}
+}
+
+// message implements the paho.Message interface
+type message struct {
+	topic, payload string
+	retained       bool
+}
+
+func (m *message) Duplicate() bool   { return false }
+func (m *message) Qos() byte         { return 0 }
+func (m *message) Retained() bool    { return m.retained }
+func (m *message) Topic() string     { return m.topic }
+func (m *message) MessageID() uint16 { return 0 }
+func (m *message) Payload() []byte   { return []byte(m.payload) }
+func (m *message) Ack()              {}
+
+// Done creates a token of an operation completed by adapter transports
+func Done(err error) paho.Token {
+	return token{err}
+}
+
+// token implements the paho.Token interface for completed operations
+type token struct {
+	err error
+}
+
+var closed = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}()
+
+func (token) Wait() bool                     { return true }
+func (token) WaitTimeout(time.Duration) bool { return true }
+func (token) Done() <-chan struct{}          { return closed }
+func (t token) Error() error                 { return t.err }
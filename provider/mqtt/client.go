@@ -2,14 +2,18 @@ package mqtt
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"math/rand"
+	"os"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/eclipse/paho.mqtt.golang/packets"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/request"
@@ -18,10 +22,48 @@ import (
 // Instance is the paho Mqtt client singleton
 var Instance *Client
 
-// ClientID created unique mqtt client id
+// ReconnectJitter is the maximum random delay before reconnecting.
+// Clients sharing a broker reconnect staggered instead of all at once when the broker restarts.
+var ReconnectJitter = 5 * time.Second
+
+// SubscribeBatch is the maximum number of topics restored by a single subscribe request
+const SubscribeBatch = 32
+
+// ErrAuthentication indicates that the broker refused the credentials
+var ErrAuthentication = errors.New("broker authentication failed")
+
+// ErrRejected indicates that the broker refused a subscription, e.g. by ACL
+var ErrRejected = errors.New("subscription rejected")
+
+// subackFailure is the suback return code of refused subscriptions
+const subackFailure = 0x80
+
+// instance distinguishes evcc processes on the same host, e.g. staging and production
+var instance = fmt.Sprintf("%04x", rand.Intn(1<<16))
+
+// clients counts the client ids created by this process
+var clients atomic.Uint32
+
+// ClientID creates a unique mqtt client id from host name and instance. Brokers disconnect clients
+// connecting using an id already in use.
 func ClientID() string {
-	pid := rand.Int31()
-	return fmt.Sprintf("evcc-%d", pid)
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "localhost"
+	}
+
+	// keep within 23 characters of MQTT 3.1 for short host names
+	host, _, _ = strings.Cut(host, ".")
+	if len(host) > 12 {
+		host = host[:12]
+	}
+
+	res := fmt.Sprintf("evcc-%s-%s", host, instance)
+	if n := clients.Add(1); n > 1 {
+		res += fmt.Sprintf("-%d", n)
+	}
+
+	return res
 }
 
 // Config is the public configuration
@@ -39,9 +81,13 @@ type Client struct {
 	mux      sync.Mutex
 	Client   paho.Client
 	broker   string
+	clientID string
 	Qos      byte
 	inflight uint32
-	listener map[string][]func(string)
+	jitter   time.Duration
+	listener map[string][]*func(string) // pointers identify listeners for removal
+	topicQos map[string]byte
+	observer []func(bool)
 }
 
 type Option func(*paho.ClientOptions)
@@ -60,8 +106,10 @@ func NewClient(log *util.Logger, broker, user, password, clientID string, qos by
 
 	mc := &Client{
 		log:      log,
+		clientID: clientID,
 		Qos:      qos,
-		listener: make(map[string][]func(string)),
+		jitter:   ReconnectJitter,
+		listener: make(map[string][]*func(string)),
 	}
 
 	options := paho.NewClientOptions()
@@ -73,6 +121,9 @@ func NewClient(log *util.Logger, broker, user, password, clientID string, qos by
 	options.SetAutoReconnect(true)
 	options.SetOnConnectHandler(mc.ConnectionHandler)
 	options.SetConnectionLostHandler(mc.ConnectionLostHandler)
+	options.SetReconnectingHandler(func(paho.Client, *paho.ClientOptions) {
+		time.Sleep(mc.delay())
+	})
 	options.SetConnectTimeout(request.Timeout)
 	options.SetOrderMatters(false)
 
@@ -95,7 +146,11 @@ func NewClient(log *util.Logger, broker, user, password, clientID string, qos by
 	log.INFO.Printf("connecting %s at %s", clientID, mc.broker)
 
 	if token := client.Connect(); token.Wait() && token.Error() != nil {
-		return nil, fmt.Errorf("error connecting: %w", token.Error())
+		err := token.Error()
+		if errors.Is(err, packets.ErrorRefusedBadUsernameOrPassword) || errors.Is(err, packets.ErrorRefusedNotAuthorised) {
+			return nil, fmt.Errorf("%w: %s: %v", ErrAuthentication, mc.broker, err)
+		}
+		return nil, fmt.Errorf("error connecting: %w", err)
 	}
 
 	mc.Client = client
@@ -103,9 +158,34 @@ func NewClient(log *util.Logger, broker, user, password, clientID string, qos by
 	return mc, nil
 }
 
+// ClientID returns the client id used for connecting the broker
+func (m *Client) ClientID() string {
+	return m.clientID
+}
+
+// Broker returns the broker address the client is connected to
+func (m *Client) Broker() string {
+	return m.broker
+}
+
+// OnConnectionChange registers a callback for connection loss and reconnects
+func (m *Client) OnConnectionChange(callback func(connected bool)) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.observer = append(m.observer, callback)
+}
+
 // ConnectionLostHandler logs cause of connection loss as warning
 func (m *Client) ConnectionLostHandler(client paho.Client, reason error) {
 	m.log.ERROR.Printf("%s connection lost: %v", m.broker, reason.Error())
+
+	m.mux.Lock()
+	observer := slices.Clone(m.observer)
+	m.mux.Unlock()
+
+	for _, cb := range observer {
+		cb(false)
+	}
 }
 
 // ConnectionHandler restores listeners
@@ -113,26 +193,97 @@ func (m *Client) ConnectionHandler(client paho.Client) {
 	m.log.DEBUG.Printf("%s connected", m.broker)
 
 	m.mux.Lock()
-	defer m.mux.Unlock()
-
+	topics := make([]string, 0, len(m.listener))
 	for topic := range m.listener {
-		m.log.DEBUG.Printf("%s subscribe %s", m.broker, topic)
-		go m.listen(topic)
+		topics = append(topics, topic)
+	}
+	observer := slices.Clone(m.observer)
+	m.mux.Unlock()
+
+	for _, cb := range observer {
+		cb(true)
+	}
+
+	if len(topics) > 0 {
+		go m.resubscribe(client, topics)
+	}
+}
+
+// delay returns a random delay up to the reconnect jitter
+func (m *Client) delay() time.Duration {
+	if m.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(m.jitter)))
+}
+
+// resubscribe restores subscriptions in batches. Reconnects are already staggered by the reconnect jitter.
+// Routes are replaced per topic, hence repeated reconnects never duplicate handlers.
+func (m *Client) resubscribe(client paho.Client, topics []string) {
+	slices.Sort(topics)
+
+	for len(topics) > 0 {
+		if !client.IsConnectionOpen() {
+			// subscriptions are restored by the next connection handler
+			return
+		}
+
+		n := min(len(topics), SubscribeBatch)
+		batch := make(map[string]byte, n)
+		for _, topic := range topics[:n] {
+			m.log.DEBUG.Printf("%s subscribe %s", m.broker, topic)
+			client.AddRoute(topic, m.handler(topic))
+			batch[topic] = m.qos(topic)
+		}
+
+		m.WaitForToken("subscribe", strings.Join(topics[:n], ","), client.SubscribeMultiple(batch, nil))
+		topics = topics[n:]
 	}
 }
 
 // Publish synchronously publishes payload using client qos
 func (m *Client) Publish(topic string, retained bool, payload interface{}) error {
+	return m.PublishQoS(topic, m.Qos, retained, payload)
+}
+
+// PublishQoS synchronously publishes payload using the given qos
+func (m *Client) PublishQoS(topic string, qos byte, retained bool, payload interface{}) error {
 	m.log.TRACE.Printf("send %s: '%v'", topic, payload)
-	token := m.Client.Publish(topic, m.Qos, retained, payload)
+	token := m.Client.Publish(topic, qos, retained, payload)
 	go m.WaitForToken("send", topic, token)
 	return nil
 }
 
+// ListenQoS attaches listener like Listen and subscribes the topic using the given qos. The qos is kept
+// for restoring the subscription on reconnect.
+func (m *Client) ListenQoS(topic string, qos byte, callback func(string)) error {
+	m.mux.Lock()
+	if m.topicQos == nil {
+		m.topicQos = make(map[string]byte)
+	}
+	m.topicQos[topic] = qos
+	m.mux.Unlock()
+
+	return m.Listen(topic, callback)
+}
+
+// qos returns the subscription qos of topic
+func (m *Client) qos(topic string) byte {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	if qos, ok := m.topicQos[topic]; ok {
+		return qos
+	}
+	return m.Qos
+}
+
 // Listen attaches listener to slice of listeners for given topic
 func (m *Client) Listen(topic string, callback func(string)) error {
+	l := &callback
+
 	m.mux.Lock()
-	m.listener[topic] = append(m.listener[topic], callback)
+	m.listener[topic] = append(m.listener[topic], l)
 	m.mux.Unlock()
 
 	token := m.listen(topic)
@@ -141,10 +292,32 @@ func (m *Client) Listen(topic string, callback func(string)) error {
 	case <-time.After(request.Timeout):
 		return fmt.Errorf("subscribe: %s: %w", topic, api.ErrTimeout)
 	case <-token.Done():
+		if st, ok := token.(*paho.SubscribeToken); ok && st.Result()[topic] == subackFailure {
+			// not restored on reconnect
+			m.mux.Lock()
+			m.removeListener(topic, l)
+			m.mux.Unlock()
+
+			return fmt.Errorf("subscribe: %s: %w", topic, ErrRejected)
+		}
 		return nil
 	}
 }
 
+// removeListener removes listener l of topic. Requires holding the lock.
+func (m *Client) removeListener(topic string, l *func(string)) {
+	// copied since handlers iterate the listeners without lock
+	res := slices.DeleteFunc(slices.Clone(m.listener[topic]), func(cb *func(string)) bool {
+		return cb == l
+	})
+
+	if len(res) > 0 {
+		m.listener[topic] = res
+	} else {
+		delete(m.listener, topic)
+	}
+}
+
 // ListenSetter creates a /set listener that resets the payload after handling
 func (m *Client) ListenSetter(topic string, callback func(string) error) error {
 	topic += "/set"
@@ -161,7 +334,12 @@ func (m *Client) ListenSetter(topic string, callback func(string) error) error {
 
 // listen attaches listener to topic
 func (m *Client) listen(topic string) paho.Token {
-	token := m.Client.Subscribe(topic, m.Qos, func(c paho.Client, msg paho.Message) {
+	return m.Client.Subscribe(topic, m.qos(topic), m.handler(topic))
+}
+
+// handler dispatches messages of topic to all its listeners
+func (m *Client) handler(topic string) paho.MessageHandler {
+	return func(c paho.Client, msg paho.Message) {
 		payload := string(msg.Payload())
 		m.log.TRACE.Printf("recv %s: '%v'", topic, payload)
 		if len(payload) > 0 {
@@ -170,11 +348,10 @@ func (m *Client) listen(topic string) paho.Token {
 			m.mux.Unlock()
 
 			for _, cb := range callbacks {
-				cb(payload)
+				(*cb)(payload)
 			}
 		}
-	})
-	return token
+	}
 }
 
 // WaitForToken synchronously waits until token operation completed
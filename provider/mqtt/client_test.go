@@ -0,0 +1,129 @@
+package mqtt
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// broker replaces routes per topic like the paho router
+type broker struct {
+	paho.Client
+	mu      sync.Mutex
+	routes  map[string]paho.MessageHandler
+	batches []int
+}
+
+func (b *broker) IsConnectionOpen() bool { return true }
+
+func (b *broker) AddRoute(topic string, callback paho.MessageHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.routes[topic] = callback
+}
+
+func (b *broker) SubscribeMultiple(filters map[string]byte, _ paho.MessageHandler) paho.Token {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.batches = append(b.batches, len(filters))
+	return Done(nil)
+}
+
+func (b *broker) subscribed() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var res int
+	for _, n := range b.batches {
+		res += n
+	}
+	return res
+}
+
+func TestReconnect(t *testing.T) {
+	const topics = 100
+
+	b := &broker{routes: make(map[string]paho.MessageHandler)}
+
+	m := &Client{
+		log:      util.NewLogger("foo"),
+		Client:   b,
+		listener: make(map[string][]*func(string)),
+	}
+
+	var mu sync.Mutex
+	recv := make(map[string]int)
+
+	for i := 0; i < topics; i++ {
+		topic := fmt.Sprintf("warp%d/evse/state", i)
+		cb := func(string) {
+			mu.Lock()
+			recv[topic]++
+			mu.Unlock()
+		}
+		m.listener[topic] = []*func(string){&cb}
+	}
+
+	// broker flapping
+	m.ConnectionHandler(b)
+	m.ConnectionHandler(b)
+
+	require.Eventually(t, func() bool {
+		return b.subscribed() == 2*topics
+	}, time.Second, 5*time.Millisecond)
+
+	b.mu.Lock()
+	for _, n := range b.batches {
+		assert.LessOrEqual(t, n, SubscribeBatch)
+	}
+	routes := b.routes
+	b.mu.Unlock()
+
+	require.Len(t, routes, topics)
+
+	for topic, h := range routes {
+		h(b, NewMessage(topic, "1", false))
+	}
+
+	// each listener is called exactly once
+	require.Len(t, recv, topics)
+	for topic, n := range recv {
+		assert.Equal(t, 1, n, topic)
+	}
+}
+
+func TestClientID(t *testing.T) {
+	id := ClientID()
+	assert.True(t, strings.HasPrefix(id, "evcc-"), id)
+	assert.Contains(t, id, instance)
+
+	// unique within process
+	assert.NotEqual(t, id, ClientID())
+}
+
+func TestRemoveListener(t *testing.T) {
+	m := &Client{listener: make(map[string][]*func(string))}
+
+	var recv []string
+	foo := func(string) { recv = append(recv, "foo") }
+	bar := func(string) { recv = append(recv, "bar") }
+
+	m.listener["topic"] = []*func(string){&foo, &bar}
+
+	// given listener is removed instead of the last one added
+	m.removeListener("topic", &foo)
+	require.Len(t, m.listener["topic"], 1)
+
+	(*m.listener["topic"][0])("")
+	assert.Equal(t, []string{"bar"}, recv)
+
+	m.removeListener("topic", &bar)
+	assert.NotContains(t, m.listener, "topic")
+}
@@ -25,6 +25,16 @@ func (r clientRegistry) Get(broker string) (*Client, error) {
 	return client, nil
 }
 
+// clientID checks if a registered client uses the id
+func (r clientRegistry) clientID(id string) bool {
+	for _, c := range r {
+		if c.clientID == id {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	mu       sync.Mutex
 	registry clientRegistry = make(map[string]*Client)
@@ -40,6 +50,8 @@ func RegisteredClient(log *util.Logger, broker, user, password, clientID string,
 	if err != nil {
 		if clientID == "" {
 			clientID = ClientID()
+		} else if registry.clientID(clientID) {
+			return nil, fmt.Errorf("duplicate client id: %s", clientID)
 		}
 
 		if client, err = NewClient(log, broker, user, password, clientID, qos, insecure, opts...); err == nil {
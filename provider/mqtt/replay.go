@@ -0,0 +1,162 @@
+package mqtt
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Capture is a recorded message as stored in capture files using one JSON object per line.
+// Payload is either a JSON string or any raw JSON value.
+type Capture struct {
+	Time    time.Time       `json:"time"`
+	Topic   string          `json:"topic"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// NewReplayClient creates a client replaying recorded messages from a capture file instead of connecting to a broker.
+// Messages are replayed on the recorded timeline relative to the first message. The last message per topic is
+// retained and delivered on subscription. Publishing is logged and discarded.
+func NewReplayClient(log *util.Logger, file string) (*Client, error) {
+	captures, err := readCaptures(file)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &replay{
+		log:      log,
+		handlers: make(map[string][]paho.MessageHandler),
+		retained: make(map[string]string),
+	}
+
+	log.WARN.Printf("replaying %d messages from %s", len(captures), file)
+	go r.run(captures)
+
+	return NewAdapterClient(log, "replay://"+file, r), nil
+}
+
+func readCaptures(file string) ([]Capture, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res []Capture
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var c Capture
+		if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", file, line, err)
+		}
+
+		res = append(res, c)
+	}
+
+	return res, scanner.Err()
+}
+
+// payload returns the string payload of a capture
+func (c Capture) payload() string {
+	var s string
+	if err := json.Unmarshal(c.Payload, &s); err == nil {
+		return s
+	}
+	return string(c.Payload)
+}
+
+// replay implements the paho.Client interface
+type replay struct {
+	log      *util.Logger
+	mu       sync.Mutex
+	handlers map[string][]paho.MessageHandler
+	retained map[string]string
+}
+
+var _ paho.Client = (*replay)(nil)
+
+func (r *replay) run(captures []Capture) {
+	if len(captures) == 0 {
+		return
+	}
+
+	start, started := captures[0].Time, time.Now()
+
+	for _, c := range captures {
+		if d := c.Time.Sub(start) - time.Since(started); d > 0 {
+			time.Sleep(d)
+		}
+
+		r.mu.Lock()
+		payload := c.payload()
+		r.retained[c.Topic] = payload
+		handlers := r.handlers[c.Topic]
+		r.mu.Unlock()
+
+		for _, h := range handlers {
+			h(r, NewMessage(c.Topic, payload, false))
+		}
+	}
+
+	r.log.WARN.Println("replay completed")
+}
+
+func (r *replay) IsConnected() bool      { return true }
+func (r *replay) IsConnectionOpen() bool { return true }
+func (r *replay) Connect() paho.Token    { return Done(nil) }
+func (r *replay) Disconnect(uint)        {}
+
+func (r *replay) Publish(topic string, _ byte, _ bool, payload interface{}) paho.Token {
+	r.log.DEBUG.Printf("replay: discard %s: '%v'", topic, payload)
+	return Done(nil)
+}
+
+func (r *replay) Subscribe(topic string, _ byte, callback paho.MessageHandler) paho.Token {
+	r.mu.Lock()
+	r.handlers[topic] = append(r.handlers[topic], callback)
+	payload, ok := r.retained[topic]
+	r.mu.Unlock()
+
+	if ok {
+		go callback(r, NewMessage(topic, payload, true))
+	}
+
+	return Done(nil)
+}
+
+func (r *replay) SubscribeMultiple(filters map[string]byte, callback paho.MessageHandler) paho.Token {
+	for topic := range filters {
+		r.Subscribe(topic, 0, callback)
+	}
+	return Done(nil)
+}
+
+func (r *replay) Unsubscribe(topics ...string) paho.Token {
+	r.mu.Lock()
+	for _, topic := range topics {
+		delete(r.handlers, topic)
+	}
+	r.mu.Unlock()
+	return Done(nil)
+}
+
+func (r *replay) AddRoute(topic string, callback paho.MessageHandler) {
+	r.Subscribe(topic, 0, callback)
+}
+
+func (r *replay) OptionsReader() paho.ClientOptionsReader {
+	return paho.ClientOptionsReader{}
+}
@@ -0,0 +1,40 @@
+package mqtt
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"time":"2024-01-01T00:00:00Z","topic":"warp/evse/state","payload":{"iec61851_state":1}}
+{"time":"2024-01-01T00:00:00.1Z","topic":"warp/evse/state","payload":"{\"iec61851_state\":2}"}
+`), 0o644))
+
+	client, err := NewReplayClient(util.NewLogger("foo"), file)
+	require.NoError(t, err)
+
+	recv := make(chan string, 2)
+	require.NoError(t, client.Listen("warp/evse/state", func(payload string) {
+		recv <- payload
+	}))
+
+	var res []string
+	for len(res) < 2 {
+		select {
+		case payload := <-recv:
+			res = append(res, payload)
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+
+	assert.Equal(t, `{"iec61851_state":2}`, res[len(res)-1])
+}
@@ -3,23 +3,61 @@ package provider
 import (
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/evcc-io/evcc/provider/pipeline"
 	"github.com/evcc-io/evcc/util"
 )
 
+// arraySettings configures parsing delimited numeric payloads
+type arraySettings struct {
+	delimiter string
+	fields    int // expected field count, any if zero
+	index     int // field read by float getters
+}
+
 type msgHandler struct {
+	mu       sync.Mutex
 	scale    float64
 	topic    string
+	truthy   []string
+	falsy    []string
+	dedup    time.Duration
+	first    time.Time
+	last     string
+	array    arraySettings
 	pipeline *pipeline.Pipeline
 	val      *util.Monitor[string]
 }
 
 func (h *msgHandler) receive(payload string) {
+	if h.dedup > 0 && h.duplicate(payload) {
+		return
+	}
+
 	h.val.Set(payload)
 }
 
+// duplicate checks if payload repeats the previous payload at subscription start
+func (h *msgHandler) duplicate(payload string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.first.IsZero() {
+		h.first = time.Now()
+	} else if time.Since(h.first) < h.dedup && payload == h.last {
+		return true
+	}
+
+	h.last = payload
+
+	return false
+}
+
 // hasValue returned the received and processed payload as string
 func (h *msgHandler) hasValue() (string, error) {
 	payload, err := h.val.Get()
@@ -36,6 +74,19 @@ func (h *msgHandler) hasValue() (string, error) {
 }
 
 func (h *msgHandler) floatGetter() (float64, error) {
+	if h.array.delimiter != "" {
+		res, err := h.floatsGetter()
+		if err != nil {
+			return 0, err
+		}
+
+		if h.array.index >= len(res) {
+			return 0, fmt.Errorf("%s invalid: missing field %d", h.topic, h.array.index)
+		}
+
+		return res[h.array.index], nil
+	}
+
 	v, err := h.hasValue()
 	if err != nil {
 		return 0, err
@@ -49,6 +100,31 @@ func (h *msgHandler) floatGetter() (float64, error) {
 	return f * h.scale, nil
 }
 
+// floatsGetter parses all fields of a delimited numeric payload
+func (h *msgHandler) floatsGetter() ([]float64, error) {
+	v, err := h.hasValue()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(strings.TrimSpace(v), h.array.delimiter)
+	if h.array.fields > 0 && len(fields) != h.array.fields {
+		return nil, fmt.Errorf("%s invalid: %d fields, expected %d", h.topic, len(fields), h.array.fields)
+	}
+
+	res := make([]float64, 0, len(fields))
+	for i, field := range fields {
+		f, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s invalid field %d: '%s'", h.topic, i, field)
+		}
+
+		res = append(res, f*h.scale)
+	}
+
+	return res, nil
+}
+
 func (h *msgHandler) intGetter() (int64, error) {
 	f, err := h.floatGetter()
 	return int64(math.Round(f)), err
@@ -69,5 +145,20 @@ func (h *msgHandler) boolGetter() (bool, error) {
 		return false, err
 	}
 
-	return util.Truish(v), nil
+	if len(h.truthy) == 0 && len(h.falsy) == 0 {
+		return util.Truish(v), nil
+	}
+
+	match := func(s string) bool {
+		return strings.EqualFold(s, strings.TrimSpace(v))
+	}
+
+	switch {
+	case slices.ContainsFunc(h.truthy, match):
+		return true, nil
+	case len(h.falsy) == 0 || slices.ContainsFunc(h.falsy, match):
+		return false, nil
+	default:
+		return false, fmt.Errorf("%s invalid: '%s'", h.topic, v)
+	}
 }
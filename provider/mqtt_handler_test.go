@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/provider/pipeline"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMqttBoolGetter(t *testing.T) {
+	newHandler := func(truthy, falsy []string) *msgHandler {
+		return &msgHandler{
+			topic:  "foo",
+			truthy: truthy,
+			falsy:  falsy,
+			val:    util.NewMonitor[string](0),
+		}
+	}
+
+	for _, tc := range []struct {
+		truthy, falsy []string
+		payload       string
+		expected      bool
+		err           bool
+	}{
+		{nil, nil, "on", true, false},
+		{nil, nil, "0", false, false},
+		{[]string{"charging"}, nil, "CHARGING", true, false},
+		{[]string{"charging"}, nil, "idle", false, false},
+		{[]string{"yes"}, []string{"no"}, "no", false, false},
+		{[]string{"yes"}, []string{"no"}, "maybe", false, true},
+	} {
+		h := newHandler(tc.truthy, tc.falsy)
+		h.receive(tc.payload)
+
+		res, err := h.boolGetter()
+		if tc.err {
+			assert.Error(t, err, tc)
+			continue
+		}
+
+		require.NoError(t, err, tc)
+		assert.Equal(t, tc.expected, res, tc)
+	}
+}
+
+func TestMqttBoolGetterJq(t *testing.T) {
+	pipe, err := pipeline.New(util.NewLogger("foo"), pipeline.Settings{Jq: ".enabled"})
+	require.NoError(t, err)
+
+	h := &msgHandler{
+		topic:    "foo",
+		pipeline: pipe,
+		val:      util.NewMonitor[string](0),
+	}
+
+	h.receive(`{"enabled":true}`)
+
+	res, err := h.boolGetter()
+	require.NoError(t, err)
+	assert.True(t, res)
+}
+
+func TestMqttStartupDedup(t *testing.T) {
+	h := &msgHandler{
+		topic: "foo",
+		dedup: time.Minute,
+		val:   util.NewMonitor[string](0),
+	}
+
+	assert.False(t, h.duplicate("1"))
+	assert.True(t, h.duplicate("1"))
+	assert.False(t, h.duplicate("2"))
+
+	// after subscription start
+	h.first = time.Now().Add(-time.Minute)
+	assert.False(t, h.duplicate("2"))
+}
+
+func TestMqttFloatsGetter(t *testing.T) {
+	h := &msgHandler{
+		topic: "foo",
+		scale: 1,
+		array: arraySettings{delimiter: ";", fields: 3, index: 2},
+		val:   util.NewMonitor[string](0),
+	}
+
+	h.receive("230.1; 229.8;231")
+
+	res, err := h.floatsGetter()
+	require.NoError(t, err)
+	assert.Equal(t, []float64{230.1, 229.8, 231}, res)
+
+	f, err := h.floatGetter()
+	require.NoError(t, err)
+	assert.Equal(t, 231.0, f)
+
+	h.receive("230.1;229.8")
+	_, err = h.floatsGetter()
+	assert.ErrorContains(t, err, "2 fields, expected 3")
+
+	h.receive("230.1;n/a;231")
+	_, err = h.floatsGetter()
+	assert.ErrorContains(t, err, "field 1: 'n/a'")
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/evcc-io/evcc/provider/mqtt"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// qosClient records the qos of subscriptions and publishes
+type qosClient struct {
+	paho.Client
+	mu         sync.Mutex
+	subscribed map[string]byte
+	published  map[string]byte
+	retained   map[string]bool
+}
+
+func newQosClient() *qosClient {
+	return &qosClient{
+		subscribed: make(map[string]byte),
+		published:  make(map[string]byte),
+		retained:   make(map[string]bool),
+	}
+}
+
+func (c *qosClient) IsConnectionOpen() bool { return true }
+
+func (c *qosClient) Subscribe(topic string, qos byte, _ paho.MessageHandler) paho.Token {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribed[topic] = qos
+	return new(paho.DummyToken)
+}
+
+func (c *qosClient) SubscribeMultiple(filters map[string]byte, _ paho.MessageHandler) paho.Token {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for topic, qos := range filters {
+		c.subscribed[topic] = qos
+	}
+	return new(paho.DummyToken)
+}
+
+func (c *qosClient) AddRoute(string, paho.MessageHandler) {}
+
+func (c *qosClient) Publish(topic string, qos byte, retained bool, _ interface{}) paho.Token {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.published[topic] = qos
+	c.retained[topic] = retained
+	return new(paho.DummyToken)
+}
+
+func (c *qosClient) qos(m map[string]byte, topic string) byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return m[topic]
+}
+
+func TestMqttQoS(t *testing.T) {
+	c := newQosClient()
+	client := mqtt.NewAdapterClient(util.NewLogger("foo"), "mock", c)
+
+	_, err := NewMqtt(util.NewLogger("foo"), client, "default", 0).StringGetter()
+	require.NoError(t, err)
+	_, err = NewMqtt(util.NewLogger("foo"), client, "qos", 0).WithQoS(1).StringGetter()
+	require.NoError(t, err)
+
+	assert.Equal(t, byte(0), c.qos(c.subscribed, "default"))
+	assert.Equal(t, byte(1), c.qos(c.subscribed, "qos"))
+
+	for topic, m := range map[string]*Mqtt{
+		"default": NewMqtt(util.NewLogger("foo"), client, "default", 0),
+		"qos":     NewMqtt(util.NewLogger("foo"), client, "qos", 0).WithQoS(2),
+	} {
+		set, err := m.WithPayload("${v}").IntSetter("v")
+		require.NoError(t, err)
+		require.NoError(t, set(1), topic)
+	}
+
+	assert.Equal(t, byte(0), c.qos(c.published, "default"))
+	assert.Equal(t, byte(2), c.qos(c.published, "qos"))
+
+	// kept on reconnect
+	c.subscribed = make(map[string]byte)
+	client.ConnectionHandler(c)
+
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.subscribed) == 2
+	}, time.Second, time.Millisecond)
+
+	assert.Equal(t, byte(0), c.qos(c.subscribed, "default"))
+	assert.Equal(t, byte(1), c.qos(c.subscribed, "qos"))
+}
+
+func TestMqttRetained(t *testing.T) {
+	c := newQosClient()
+	client := mqtt.NewAdapterClient(util.NewLogger("foo"), "mock", c)
+
+	for topic, retained := range map[string]bool{
+		"default":  false,
+		"retained": true,
+	} {
+		m := NewMqtt(util.NewLogger("foo"), client, topic, 0).WithRetained(retained)
+
+		// getters don't publish
+		_, err := m.StringGetter()
+		require.NoError(t, err)
+
+		set, err := m.WithPayload("${v}").IntSetter("v")
+		require.NoError(t, err)
+		require.NoError(t, set(1), topic)
+	}
+
+	assert.Len(t, c.retained, 2)
+	assert.False(t, c.retained["default"])
+	assert.True(t, c.retained["retained"])
+
+	// retained flag can be reset
+	set, err := NewMqtt(util.NewLogger("foo"), client, "reset", 0).WithRetained(true).WithRetained(false).IntSetter("v")
+	require.NoError(t, err)
+	require.NoError(t, set(1))
+	assert.False(t, c.retained["reset"])
+}
@@ -1,12 +1,77 @@
 package provider
 
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
 // TimeoutHandler is a wrapper for a Getter that times out after a given duration
 type TimeoutHandler struct {
-	ticker func() (string, error)
+	ticker   func() (string, error)
+	clock    clock.Clock
+	log      *util.Logger
+	mu       sync.Mutex
+	grace    time.Duration
+	received bool
+	stale    time.Time
 }
 
 func NewTimeoutHandler(ticker func() (string, error)) *TimeoutHandler {
-	return &TimeoutHandler{ticker}
+	return &TimeoutHandler{
+		ticker: ticker,
+		clock:  clock.New(),
+	}
+}
+
+// WithGrace keeps serving the last values for the grace duration once the ticker is outdated before failing
+func (h *TimeoutHandler) WithGrace(log *util.Logger, grace time.Duration) *TimeoutHandler {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.log = log
+	h.grace = grace
+	return h
+}
+
+// check returns the ticker's error unless within grace of an outdated ticker
+func (h *TimeoutHandler) check() error {
+	_, err := h.ticker()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.grace == 0 {
+		return err
+	}
+
+	if err == nil {
+		if !h.stale.IsZero() {
+			h.log.DEBUG.Println("updated after outdated data")
+		}
+		h.received = true
+		h.stale = time.Time{}
+		return nil
+	}
+
+	// without any values received there is nothing to hold
+	if !h.received || !errors.Is(err, api.ErrOutdated) {
+		return err
+	}
+
+	if h.stale.IsZero() {
+		h.stale = h.clock.Now()
+		h.log.WARN.Printf("%v, using last values for %v", err, h.grace)
+	}
+
+	if h.clock.Since(h.stale) < h.grace {
+		return nil
+	}
+
+	return err
 }
 
 func (h *TimeoutHandler) BoolGetter(p BoolProvider) (func() (bool, error), error) {
@@ -17,7 +82,7 @@ func (h *TimeoutHandler) BoolGetter(p BoolProvider) (func() (bool, error), error
 
 	return func() (val bool, err error) {
 		if val, err = g(); err == nil {
-			_, err = h.ticker()
+			err = h.check()
 		}
 		return val, err
 	}, nil
@@ -31,7 +96,7 @@ func (h *TimeoutHandler) FloatGetter(p FloatProvider) (func() (float64, error),
 
 	return func() (val float64, err error) {
 		if val, err = g(); err == nil {
-			_, err = h.ticker()
+			err = h.check()
 		}
 		return val, err
 	}, nil
@@ -45,7 +110,7 @@ func (h *TimeoutHandler) StringGetter(p StringProvider) (func() (string, error),
 
 	return func() (val string, err error) {
 		if val, err = g(); err == nil {
-			_, err = h.ticker()
+			err = h.check()
 		}
 		return val, err
 	}, nil
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stringProvider func() (string, error)
+
+func (p stringProvider) StringGetter() (func() (string, error), error) {
+	return p, nil
+}
+
+func TestTimeoutHandlerGrace(t *testing.T) {
+	var tick error
+	h := NewTimeoutHandler(func() (string, error) {
+		return "", tick
+	}).WithGrace(util.NewLogger("foo"), time.Minute)
+
+	clock := clock.NewMock()
+	h.clock = clock
+
+	g, err := h.StringGetter(stringProvider(func() (string, error) {
+		return "last", nil
+	}))
+	require.NoError(t, err)
+
+	// outdated before any value received
+	tick = api.ErrOutdated
+	_, err = g()
+	assert.ErrorIs(t, err, api.ErrOutdated)
+
+	// fresh
+	tick = nil
+	res, err := g()
+	require.NoError(t, err)
+	assert.Equal(t, "last", res)
+
+	// grace
+	tick = api.ErrOutdated
+	res, err = g()
+	require.NoError(t, err)
+	assert.Equal(t, "last", res)
+
+	clock.Add(59 * time.Second)
+	_, err = g()
+	require.NoError(t, err)
+
+	// hard fail
+	clock.Add(time.Second)
+	_, err = g()
+	assert.ErrorIs(t, err, api.ErrOutdated)
+
+	// other errors are not held
+	tick = nil
+	_, err = g()
+	require.NoError(t, err)
+
+	tick = errors.New("foo")
+	_, err = g()
+	assert.EqualError(t, err, "foo")
+
+	// grace restarts after update
+	tick = api.ErrOutdated
+	_, err = g()
+	require.NoError(t, err)
+}
+
+func TestTimeoutHandlerWithoutGrace(t *testing.T) {
+	h := NewTimeoutHandler(func() (string, error) {
+		return "", api.ErrOutdated
+	})
+
+	g, err := h.StringGetter(stringProvider(func() (string, error) {
+		return "last", nil
+	}))
+	require.NoError(t, err)
+
+	_, err = g()
+	assert.ErrorIs(t, err, api.ErrOutdated)
+}
@@ -2,9 +2,17 @@ package server
 
 import (
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/util/config"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNaNInf(t *testing.T) {
@@ -15,3 +23,55 @@ func TestNaNInf(t *testing.T) {
 	encodeFloats(c)
 	assert.Equal(t, map[string]any{"foo": nil, "bar": nil}, c, "NaN not encoded as nil")
 }
+
+// lpSite is a site with the given loadpoints
+type lpSite struct {
+	site.API
+	loadpoints []loadpoint.API
+}
+
+func (s lpSite) Loadpoints() []loadpoint.API { return s.loadpoints }
+
+func TestDisableChargers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp1 := loadpoint.NewMockAPI(ctrl)
+	lp2 := loadpoint.NewMockAPI(ctrl)
+
+	lp1.EXPECT().SetMode(api.ModeOff).Times(2)
+	lp2.EXPECT().SetMode(api.ModeOff).Times(2)
+
+	foo := api.NewMockCharger(ctrl)
+	bar := api.NewMockCharger(ctrl)
+
+	for name, c := range map[string]api.Charger{"foo": foo, "bar": bar} {
+		require.NoError(t, config.Chargers().Add(config.NewStaticDevice(config.Named{Name: name}, c)))
+
+		name := name
+		t.Cleanup(func() { _ = config.Chargers().Delete(name) })
+	}
+
+	handler := disableChargersHandler(lpSite{loadpoints: []loadpoint.API{lp1, lp2}})
+
+	{
+		foo.EXPECT().Enable(false).Return(nil)
+		bar.EXPECT().Enable(false).Return(nil)
+
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/chargers/disable", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"result":{"foo":"ok","bar":"ok"}}`, w.Body.String())
+	}
+
+	{
+		foo.EXPECT().Enable(false).Return(nil)
+		bar.EXPECT().Enable(false).Return(api.ErrTimeout)
+
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/chargers/disable", nil))
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+		assert.JSONEq(t, `{"result":{"foo":"ok","bar":"bar: timeout"}}`, w.Body.String())
+	}
+}
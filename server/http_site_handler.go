@@ -12,9 +12,11 @@ import (
 	"text/template"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/charger"
 	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/server/assets"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/config"
 	"github.com/evcc-io/evcc/util/jq"
 	"github.com/gorilla/mux"
 	"github.com/itchyny/gojq"
@@ -208,6 +210,38 @@ func healthHandler(site site.API) http.HandlerFunc {
 	}
 }
 
+// disableChargersHandler disables all chargers concurrently, e.g. for load shedding. Loadpoints are switched
+// off before to keep their chargers disabled until the mode is changed. Responds with the result per charger.
+func disableChargersHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, lp := range site.Loadpoints() {
+			lp.SetMode(api.ModeOff)
+		}
+
+		chargers := make(map[string]api.Charger)
+		for _, dev := range config.Chargers().Devices() {
+			chargers[dev.Config().Name] = dev.Instance()
+		}
+
+		var failed bool
+
+		res := make(map[string]string)
+		for name, err := range charger.EnableAll(chargers, false) {
+			res[name] = "ok"
+			if err != nil {
+				res[name] = err.Error()
+				failed = true
+			}
+		}
+
+		if failed {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+
+		jsonResult(w, res)
+	}
+}
+
 // tariffHandler returns the configured tariff
 func tariffHandler(site site.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
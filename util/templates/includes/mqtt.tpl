@@ -6,6 +6,9 @@ user: {{ .user }}
 {{- if .password }}
 password: {{ .password }}
 {{- end }}
+{{- if .clientid }}
+clientid: {{ .clientid }}
+{{- end }}
 {{- if ne .timeout "30s" }}
 timeout: {{ .timeout }}
 {{- end }}